@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/golang/geo/s2"
+)
+
+// Aggregator reduces the values of the points falling in one cell to a single value.
+type Aggregator struct {
+	// Init is the accumulator of a cell before any value is added.
+	Init float64
+	// Add folds a value into the accumulator. Values are added in point order.
+	Add func(acc, v float64) float64
+	// Finish, if set, maps the final accumulator and the number of values added to the result
+	// of the cell. Otherwise the accumulator is the result.
+	Finish func(acc float64, n int) float64
+}
+
+// Predefined aggregators. Empty cells aggregate to 0 under AggregateSum and AggregateCount,
+// and to NaN under AggregateMean and AggregateMax.
+var (
+	// AggregateSum sums the values.
+	AggregateSum = Aggregator{Add: func(acc, v float64) float64 { return acc + v }}
+	// AggregateCount counts the values.
+	AggregateCount = Aggregator{Add: func(acc, _ float64) float64 { return acc + 1 }}
+	// AggregateMean averages the values.
+	AggregateMean = Aggregator{
+		Add:    func(acc, v float64) float64 { return acc + v },
+		Finish: func(acc float64, n int) float64 { return acc / float64(n) },
+	}
+	// AggregateMax takes the largest value.
+	AggregateMax = Aggregator{
+		Init: math.Inf(-1),
+		Add:  math.Max,
+		Finish: func(acc float64, n int) float64 {
+			if n == 0 {
+				return math.NaN()
+			}
+			return acc
+		},
+	}
+)
+
+// Aggregate assigns every point to the cell containing it and reduces the values of the points
+// in each cell with agg, returning one result per cell. Points are located in parallel, with
+// walks seeded by the previous point, so spatially coherent point orders aggregate fastest.
+// It returns an error if values does not have one value per point or agg.Add is nil.
+func (d *Diagram) Aggregate(points s2.PointVector, values []float64,
+	agg Aggregator) ([]float64, error) {
+	if len(values) != len(points) {
+		return nil, fmt.Errorf("Aggregate: got %d values for %d points", len(values),
+			len(points))
+	}
+	if agg.Add == nil {
+		return nil, fmt.Errorf("Aggregate: %w: agg.Add must not be nil", ErrInvalidOption)
+	}
+
+	acc := make([]float64, d.NumCells())
+	counts := make([]int, d.NumCells())
+	for i := range acc {
+		acc[i] = agg.Init
+	}
+	for i, c := range d.locateAll(points) {
+		acc[c] = agg.Add(acc[c], values[i])
+		counts[c]++
+	}
+	if agg.Finish != nil {
+		for i := range acc {
+			acc[i] = agg.Finish(acc[i], counts[i])
+		}
+	}
+	return acc, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// Aggregate
+
+func TestDiagram_Aggregate(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	points := utils.GenerateRandomPoints(5000, 1)
+	values := make([]float64, len(points))
+	for i, p := range points {
+		values[i] = p.X + 2*p.Y*p.Z
+	}
+
+	// Brute-force assignment by nearest site.
+	sums := make([]float64, vd.NumCells())
+	counts := make([]float64, vd.NumCells())
+	maxes := make([]float64, vd.NumCells())
+	for i := range maxes {
+		maxes[i] = math.NaN()
+	}
+	for i, p := range points {
+		best := 0
+		for j, s := range vd.Sites {
+			if s2.CompareDistances(p, s, vd.Sites[best]) < 0 {
+				best = j
+			}
+		}
+		sums[best] += values[i]
+		counts[best]++
+		if math.IsNaN(maxes[best]) || values[i] > maxes[best] {
+			maxes[best] = values[i]
+		}
+	}
+	means := make([]float64, vd.NumCells())
+	for i := range means {
+		means[i] = sums[i] / counts[i]
+	}
+
+	tests := []struct {
+		name string
+		agg  Aggregator
+		want []float64
+	}{
+		{"sum", AggregateSum, sums},
+		{"count", AggregateCount, counts},
+		{"mean", AggregateMean, means},
+		{"max", AggregateMax, maxes},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vd.Aggregate(points, values, tt.agg)
+			if err != nil {
+				t.Fatalf("vd.Aggregate(...) error = %v, want nil", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateApprox(0, 1e-12),
+				cmpopts.EquateNaNs()); diff != "" {
+				t.Errorf("vd.Aggregate(...) mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagram_Aggregate_EmptyCells(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	tests := []struct {
+		name string
+		agg  Aggregator
+		want float64
+	}{
+		{"sum", AggregateSum, 0},
+		{"count", AggregateCount, 0},
+		{"mean", AggregateMean, math.NaN()},
+		{"max", AggregateMax, math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vd.Aggregate(nil, nil, tt.agg)
+			if err != nil {
+				t.Fatalf("vd.Aggregate(...) error = %v, want nil", err)
+			}
+			for i, v := range got {
+				if v != tt.want && !(math.IsNaN(v) && math.IsNaN(tt.want)) {
+					t.Errorf("vd.Aggregate(...)[%d] = %v, want %v", i, v, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDiagram_Aggregate_Invalid(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	points := utils.GenerateRandomPoints(5, 0)
+	if _, err := vd.Aggregate(points, make([]float64, 4), AggregateSum); err == nil {
+		t.Errorf("vd.Aggregate(mismatched lengths) error = nil, want non-nil")
+	}
+	_, err := vd.Aggregate(points, make([]float64, 5), Aggregator{})
+	if !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("vd.Aggregate(nil Add) error = %v, want ErrInvalidOption", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// AssignBalanced assigns every point to a cell without exceeding the per-cell capacities,
+// returning the cell index of each point. Points are processed in order of the distance to
+// their nearest site, closest first, and each takes the nearest cell that still has capacity,
+// spilling to the next-nearest cells when full. The next-nearest sites are enumerated by a
+// best-first walk of the neighbor graph, since the k nearest sites of a point always form a
+// connected subgraph of the Delaunay triangulation.
+// It returns an error if capacities does not have one non-negative entry per cell or the total
+// capacity is less than the number of points, or wrapping ErrNoNeighbors if the diagram was
+// built WithoutNeighbors.
+func (d *Diagram) AssignBalanced(points s2.PointVector, capacities []int) ([]int, error) {
+	if !d.hasNeighbors() {
+		return nil, fmt.Errorf("AssignBalanced: %w", ErrNoNeighbors)
+	}
+	if len(capacities) != d.NumCells() {
+		return nil, fmt.Errorf("AssignBalanced: got %d capacities for %d cells", len(capacities),
+			d.NumCells())
+	}
+	total := 0
+	for i, c := range capacities {
+		if c < 0 {
+			return nil, fmt.Errorf("AssignBalanced: capacity %d of cell %d is negative", c, i)
+		}
+		total += c
+	}
+	if total < len(points) {
+		return nil, fmt.Errorf("AssignBalanced: total capacity %d is less than %d points", total,
+			len(points))
+	}
+
+	nearest := make([]int, len(points))
+	order := make([]int, len(points))
+	hint := 0
+	for i, p := range points {
+		nearest[i] = d.locate(p, hint)
+		hint = nearest[i]
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(points[a].Distance(d.Sites[nearest[a]]),
+			points[b].Distance(d.Sites[nearest[b]]))
+	})
+
+	remaining := slices.Clone(capacities)
+	assignment := make([]int, len(points))
+	for _, i := range order {
+		c := d.nearestWithCapacity(points[i], nearest[i], remaining)
+		remaining[c]--
+		assignment[i] = c
+	}
+	return assignment, nil
+}
+
+// nearestWithCapacity returns the cell nearest to p with remaining capacity, expanding
+// best-first over the neighbor graph from start, the cell containing p. Ties are broken by
+// cell index.
+func (d *Diagram) nearestWithCapacity(p s2.Point, start int, remaining []int) int {
+	seen := map[int]bool{start: true}
+	pq := &siteQueue{{site: start, dist: p.Distance(d.Sites[start])}}
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(siteDistance)
+		if remaining[cur.site] > 0 {
+			return cur.site
+		}
+		for _, n := range (Cell{idx: cur.site, d: d}).NeighborIndices() {
+			if !seen[n] {
+				seen[n] = true
+				heap.Push(pq, siteDistance{site: n, dist: p.Distance(d.Sites[n])})
+			}
+		}
+	}
+	// Unreachable when the total remaining capacity is positive.
+	return start
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Assign
+
+func TestDiagram_AssignBalanced(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	points := utils.GenerateRandomPoints(1000, 1)
+
+	unlimited := make([]int, vd.NumCells())
+	for i := range unlimited {
+		unlimited[i] = len(points)
+	}
+	got, err := vd.AssignBalanced(points, unlimited)
+	if err != nil {
+		t.Fatalf("vd.AssignBalanced(..., unlimited) error = %v, want nil", err)
+	}
+	want := make([]int, len(points))
+	for i, p := range points {
+		want[i] = nearestSiteBruteForce(vd, p)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("vd.AssignBalanced(..., unlimited) mismatch (-want +got):\n%s", diff)
+	}
+
+	capacities := make([]int, vd.NumCells())
+	for i := range capacities {
+		capacities[i] = 10
+	}
+	got, err = vd.AssignBalanced(points, capacities)
+	if err != nil {
+		t.Fatalf("vd.AssignBalanced(..., tight) error = %v, want nil", err)
+	}
+	counts := make([]int, vd.NumCells())
+	for _, c := range got {
+		counts[c]++
+	}
+	for c, n := range counts {
+		if n != capacities[c] {
+			t.Errorf("cell %d assigned %d points, want %d", c, n, capacities[c])
+		}
+	}
+	// A point only spills past a cell that ended up full.
+	for i, p := range points {
+		assigned := p.Distance(vd.Sites[got[i]])
+		for c, s := range vd.Sites {
+			if p.Distance(s) < assigned && counts[c] < capacities[c] {
+				t.Errorf("point %d assigned to %d while nearer cell %d has capacity", i, got[i], c)
+			}
+		}
+	}
+}
+
+func TestDiagram_AssignBalanced_InvalidInput(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	points := utils.GenerateRandomPoints(20, 1)
+	full := func(c int) []int {
+		s := make([]int, vd.NumCells())
+		for i := range s {
+			s[i] = c
+		}
+		return s
+	}
+	tests := []struct {
+		name       string
+		capacities []int
+	}{
+		{"wrong length", []int{100}},
+		{"negative", append(full(5)[1:], -1)},
+		{"insufficient", full(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := vd.AssignBalanced(points, tt.capacities); err == nil {
+				t.Errorf("vd.AssignBalanced(...) error = nil, want non-nil")
+			}
+		})
+	}
+}
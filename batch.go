@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/golang/geo/s2"
+)
+
+// ProgressFunc receives the number of finished constructions out of total. It is called from
+// one goroutine at a time, once per site set in completion order.
+type ProgressFunc func(done, total int)
+
+// BuildDiagrams builds one diagram per site set with NewDiagram, distributing the
+// constructions across parallelism workers. A non-positive parallelism uses
+// runtime.GOMAXPROCS(0) workers.
+// The result slices are indexed like siteSets: a failed construction leaves a nil diagram and
+// its error at that index without affecting the other site sets.
+// Workers do not share scratch storage between constructions: nearly all allocations are made
+// by the convex hull, which keeps none between runs, so each construction allocates as much as
+// a NewDiagram call.
+func BuildDiagrams(siteSets []s2.PointVector, parallelism int,
+	setters ...DiagramOption) ([]*Diagram, []error) {
+	return BuildDiagramsWithProgress(siteSets, parallelism, nil, setters...)
+}
+
+// BuildDiagramsWithProgress is like BuildDiagrams, and also calls progress, if not nil, after
+// each construction finishes, successfully or not.
+func BuildDiagramsWithProgress(siteSets []s2.PointVector, parallelism int, progress ProgressFunc,
+	setters ...DiagramOption) ([]*Diagram, []error) {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	parallelism = min(parallelism, len(siteSets))
+
+	diagrams := make([]*Diagram, len(siteSets))
+	errs := make([]error, len(siteSets))
+	jobs := make(chan int)
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		done int
+	)
+	for range parallelism {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				diagrams[i], errs[i] = NewDiagram(siteSets[i], setters...)
+				if progress != nil {
+					mu.Lock()
+					done++
+					progress(done, len(siteSets))
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range siteSets {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return diagrams, errs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Batch
+
+func TestBuildDiagrams(t *testing.T) {
+	siteSets := make([]s2.PointVector, 20)
+	for i := range siteSets {
+		siteSets[i] = utils.GenerateRandomPoints(50+10*i, int64(i))
+	}
+	siteSets[7] = siteSets[7][:3]
+
+	for _, parallelism := range []int{0, 1, 4, 100} {
+		t.Run(fmt.Sprintf("P%d", parallelism), func(t *testing.T) {
+			diagrams, errs := BuildDiagrams(siteSets, parallelism)
+			if len(diagrams) != len(siteSets) || len(errs) != len(siteSets) {
+				t.Fatalf("BuildDiagrams(...) returned %d diagrams and %d errors, want %d",
+					len(diagrams), len(errs), len(siteSets))
+			}
+			for i, sites := range siteSets {
+				want, wantErr := NewDiagram(sites)
+				if wantErr != nil {
+					if !errors.Is(errs[i], ErrInsufficientSites) || diagrams[i] != nil {
+						t.Errorf("item %d: got (%v, %v), want (nil, ErrInsufficientSites)", i,
+							diagrams[i], errs[i])
+					}
+					continue
+				}
+				if errs[i] != nil {
+					t.Fatalf("item %d: error = %v, want nil", i, errs[i])
+				}
+				got := diagrams[i]
+				if diff := cmp.Diff(want.Vertices, got.Vertices); diff != "" {
+					t.Errorf("item %d: Vertices mismatch (-want +got):\n%s", i, diff)
+				}
+				if diff := cmp.Diff(want.CellNeighbors, got.CellNeighbors); diff != "" {
+					t.Errorf("item %d: CellNeighbors mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildDiagramsWithProgress(t *testing.T) {
+	siteSets := make([]s2.PointVector, 10)
+	for i := range siteSets {
+		siteSets[i] = utils.GenerateRandomPoints(50, int64(i))
+	}
+	siteSets[3] = siteSets[3][:3]
+
+	var calls []int
+	_, errs := BuildDiagramsWithProgress(siteSets, 4, func(done, total int) {
+		if total != len(siteSets) {
+			t.Errorf("progress total = %d, want %d", total, len(siteSets))
+		}
+		calls = append(calls, done)
+	})
+	if errs[3] == nil {
+		t.Errorf("item 3: error = nil, want non-nil")
+	}
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !slices.Equal(calls, want) {
+		t.Errorf("progress calls = %v, want %v", calls, want)
+	}
+}
+
+func TestBuildDiagrams_Empty(t *testing.T) {
+	diagrams, errs := BuildDiagrams(nil, 0)
+	if len(diagrams) != 0 || len(errs) != 0 {
+		t.Errorf("BuildDiagrams(nil, 0) = (%v, %v), want empty", diagrams, errs)
+	}
+}
+
+// Benchmarks
+
+func BenchmarkBuildDiagrams(b *testing.B) {
+	siteSets := make([]s2.PointVector, 256)
+	for i := range siteSets {
+		siteSets[i] = utils.GenerateRandomPoints(50+i, int64(i))
+	}
+
+	b.Run("Loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for _, sites := range siteSets {
+				if _, err := NewDiagram(sites); err != nil {
+					b.Fatalf("NewDiagram(...) error = %v, want nil", err)
+				}
+			}
+		}
+	})
+	for _, parallelism := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("P%d", parallelism), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				BuildDiagrams(siteSets, parallelism)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// diagramCache holds lazily computed data derived from a Diagram. Each entry is populated at
+// most once and is safe for concurrent readers.
+type diagramCache struct {
+	areasOnce sync.Once
+	// areaBits holds the float64 bits of each computed cell area, or zero if not yet computed.
+	areaBits []atomic.Uint64
+
+	idsOnce sync.Once
+	cellIDs map[uint64]int
+	idsErr  error
+}
+
+// caches returns the current cache container, creating it on first use.
+func (d *Diagram) caches() *diagramCache {
+	if c := d.cache.Load(); c != nil {
+		return c
+	}
+	d.cache.CompareAndSwap(nil, &diagramCache{})
+	return d.cache.Load()
+}
+
+// InvalidateCaches discards all lazily computed data so that later accessors recompute it.
+// Operations that mutate the diagram call it; callers that modify the exported slices
+// directly must call it themselves. It must not be called concurrently with mutations.
+func (d *Diagram) InvalidateCaches() {
+	d.cache.Store(nil)
+}
+
+// cellArea returns the area of cell i in steradians, computing and memoizing it on first use.
+// Concurrent first uses may compute the same value more than once.
+func (d *Diagram) cellArea(i int) float64 {
+	c := d.caches()
+	c.areasOnce.Do(func() {
+		c.areaBits = make([]atomic.Uint64, d.NumCells())
+	})
+	if bits := c.areaBits[i].Load(); bits != 0 {
+		return math.Float64frombits(bits)
+	}
+	a := Cell{idx: i, d: d}.Loop().Area()
+	c.areaBits[i].Store(math.Float64bits(a))
+	return a
+}
+
+// PrecomputeAreas computes and caches the area of every cell in one parallel pass, so that
+// later Cell.Area calls and area-based queries do not compute them on demand.
+func (d *Diagram) PrecomputeAreas() {
+	n := d.NumCells()
+	workers := min(runtime.GOMAXPROCS(0), n)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := w; i < n; i += workers {
+				d.cellArea(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Warm precomputes all lazily cached data, the cell areas and the cell ID index, so that the
+// first queries on the diagram do not pay for building them.
+func (d *Diagram) Warm() {
+	d.PrecomputeAreas()
+	_, _ = d.cellIDIndex()
+}
+
+// CellAreas returns the area of every cell in steradians, indexed like Sites, computing the
+// missing ones in one parallel pass. The areas of a complete diagram sum to 4π up to rounding.
+func (d *Diagram) CellAreas() []float64 {
+	d.PrecomputeAreas()
+	areas := make([]float64, d.NumCells())
+	for i := range areas {
+		areas[i] = d.cellArea(i)
+	}
+	return areas
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package cache stores triangulations and diagrams on disk, keyed by everything that affects
+// their construction, and rebuilds them when the stored copy is missing, stale or corrupt.
+
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+
+	"github.com/2dChan/s2voronoi"
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/s2"
+)
+
+// FormatVersion is the version of the cache file layout. It is part of every key, so files
+// written by other versions are rebuilt.
+const FormatVersion = 1
+
+const (
+	modulePath = "github.com/2dChan/s2voronoi"
+	fileMagic  = "S2CF"
+	headerSize = len(fileMagic) + 2*sha256.Size
+)
+
+// ErrUncacheable reports options whose effect on the output cannot be fingerprinted, such as a
+// vertex override callback.
+var ErrUncacheable = errors.New("options cannot be fingerprinted")
+
+// LoadOrBuildTriangulation returns the triangulation of the vertices with the given options,
+// loading it from the file at path when the file was written for the same vertices, options,
+// format version and library version, and otherwise building it and atomically replacing the
+// file. A corrupt or structurally invalid file is treated as missing. Concurrent callers,
+// including other processes, may share a path.
+// Only options that affect the output are part of the key, so metrics and diagnostics sinks do
+// not cause a rebuild. When the triangulation is loaded, the metrics report only its sizes and
+// the diagnostics are left untouched.
+// It returns an error if an option is invalid, the triangulation cannot be built, or the file
+// cannot be read or written.
+func LoadOrBuildTriangulation(path string, vertices s2.PointVector,
+	opts ...s2delaunay.TriangulationOption) (*s2delaunay.Triangulation, error) {
+	t, _, err := loadOrBuildTriangulation(path, vertices, FormatVersion, opts)
+	if err != nil {
+		return nil, fmt.Errorf("LoadOrBuildTriangulation: %w", err)
+	}
+	return t, nil
+}
+
+// LoadOrBuildDiagram is like LoadOrBuildTriangulation for the Voronoi diagram built by
+// s2voronoi.NewDiagram. Options with a vertex override cannot be cached. When the diagram is
+// loaded, the metrics report only its sizes.
+// It returns an error wrapping ErrUncacheable for such options, or an error if an option is
+// invalid, the diagram cannot be built, or the file cannot be read or written.
+func LoadOrBuildDiagram(path string, sites s2.PointVector,
+	opts ...s2voronoi.DiagramOption) (*s2voronoi.Diagram, error) {
+	d, _, err := loadOrBuildDiagram(path, sites, FormatVersion, opts)
+	if err != nil {
+		return nil, fmt.Errorf("LoadOrBuildDiagram: %w", err)
+	}
+	return d, nil
+}
+
+// loadOrBuildTriangulation implements LoadOrBuildTriangulation, also reporting whether the
+// triangulation was loaded from the file.
+func loadOrBuildTriangulation(path string, vertices s2.PointVector, version int,
+	setters []s2delaunay.TriangulationOption) (*s2delaunay.Triangulation, bool, error) {
+	var opts s2delaunay.TriangulationOptions
+	for _, set := range setters {
+		if err := set(&opts); err != nil {
+			return nil, false, err
+		}
+	}
+	key := cacheKey("triangulation", version, triangulationOptionsKey(opts), vertices)
+
+	t := new(s2delaunay.Triangulation)
+	loaded, err := loadOrBuild(path, key, t, func() (encoding.BinaryMarshaler, error) {
+		var err error
+		t, err = s2delaunay.NewTriangulation(vertices, setters...)
+		return t, err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if m := opts.Metrics; m != nil && loaded {
+		*m = s2delaunay.BuildMetrics{Vertices: len(t.Vertices), Triangles: len(t.Triangles)}
+	}
+	return t, loaded, nil
+}
+
+// loadOrBuildDiagram implements LoadOrBuildDiagram, also reporting whether the diagram was
+// loaded from the file.
+func loadOrBuildDiagram(path string, sites s2.PointVector, version int,
+	setters []s2voronoi.DiagramOption) (*s2voronoi.Diagram, bool, error) {
+	var opts s2voronoi.DiagramOptions
+	for _, set := range setters {
+		if err := set(&opts); err != nil {
+			return nil, false, err
+		}
+	}
+	if opts.VertexOverride != nil {
+		return nil, false, fmt.Errorf("%w: vertex override", ErrUncacheable)
+	}
+	key := cacheKey("diagram", version, diagramOptionsKey(opts), sites)
+
+	d := new(s2voronoi.Diagram)
+	loaded, err := loadOrBuild(path, key, d, func() (encoding.BinaryMarshaler, error) {
+		var err error
+		d, err = s2voronoi.NewDiagram(sites, setters...)
+		return d, err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if m := opts.Metrics; m != nil && loaded {
+		*m = s2voronoi.BuildMetrics{Sites: len(d.Sites), Vertices: len(d.Vertices)}
+	}
+	return d, loaded, nil
+}
+
+// triangulationOptionsKey formats the options that affect the built triangulation.
+func triangulationOptionsKey(o s2delaunay.TriangulationOptions) string {
+	return fmt.Sprintf("eps=%v auto=%v id=%d partial=%v fix=%v seed=%v dedup=%v tol=%v norm=%v",
+		o.Eps, o.AutoEps, o.IDLevel, o.PartialResults, o.FixOrientation, o.HullSeed,
+		o.Deduplicate, float64(o.DeduplicationTolerance), o.Normalize)
+}
+
+// diagramOptionsKey formats the options that affect the built diagram.
+func diagramOptionsKey(o s2voronoi.DiagramOptions) string {
+	return fmt.Sprintf("eps=%v auto=%v id=%d radius=%v repair=%v nonb=%v fix=%v",
+		o.Eps, o.AutoEps, o.IDLevel, float64(o.MaxRadius), o.RingRepair, o.WithoutNeighbors,
+		o.FixOrientation)
+}
+
+// loadOrBuild decodes the file at path into dst if its key and checksum match, and otherwise
+// calls build and writes the result to path. It reports whether dst was loaded.
+func loadOrBuild(path string, key [sha256.Size]byte, dst encoding.BinaryUnmarshaler,
+	build func() (encoding.BinaryMarshaler, error)) (bool, error) {
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if payload, ok := checkFile(data, key); ok && dst.UnmarshalBinary(payload) == nil {
+			return true, nil
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return false, err
+	}
+
+	v, err := build()
+	if err != nil {
+		return false, err
+	}
+	payload, err := v.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	return false, writeFile(path, key, payload)
+}
+
+// cacheKey fingerprints everything that determines the built output.
+func cacheKey(kind string, version int, opts string, points s2.PointVector) [sha256.Size]byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%d\x00%s\x00%s\x00", kind, version, moduleVersion(), opts)
+	var b [8]byte
+	for _, p := range points {
+		for _, c := range [3]float64{p.X, p.Y, p.Z} {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(c))
+			h.Write(b[:])
+		}
+	}
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+	return key
+}
+
+// moduleVersion returns the version of this module in the running binary, or "(devel)" when it
+// is unknown, such as for builds of the module itself.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
+
+// checkFile returns the payload of a cache file if it carries the key and a valid checksum.
+func checkFile(data []byte, key [sha256.Size]byte) ([]byte, bool) {
+	if len(data) < headerSize || string(data[:len(fileMagic)]) != fileMagic {
+		return nil, false
+	}
+	data = data[len(fileMagic):]
+	if !bytes.Equal(data[:sha256.Size], key[:]) {
+		return nil, false
+	}
+	sum, payload := data[sha256.Size:2*sha256.Size], data[2*sha256.Size:]
+	if got := sha256.Sum256(payload); !bytes.Equal(sum, got[:]) {
+		return nil, false
+	}
+	return payload, true
+}
+
+// writeFile writes a cache file next to path and renames it into place, so readers never see a
+// partially written file.
+func writeFile(path string, key [sha256.Size]byte, payload []byte) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	sum := sha256.Sum256(payload)
+	for _, b := range [][]byte{[]byte(fileMagic), key[:], sum[:], payload} {
+		if _, err := f.Write(b); err != nil {
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package cache
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/2dChan/s2voronoi"
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// Cache
+
+func TestLoadOrBuildTriangulation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dt.cache")
+	vertices := utils.GenerateRandomPoints(100, 0)
+	want, err := s2delaunay.NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+
+	for i, wantLoaded := range []bool{false, true} {
+		got, loaded, err := loadOrBuildTriangulation(path, vertices, FormatVersion, nil)
+		if err != nil {
+			t.Fatalf("call %d: loadOrBuildTriangulation(...) error = %v, want nil", i, err)
+		}
+		if loaded != wantLoaded {
+			t.Errorf("call %d: loaded = %v, want %v", i, loaded, wantLoaded)
+		}
+		opt := cmpopts.IgnoreUnexported(s2delaunay.Triangulation{})
+		if diff := cmp.Diff(want, got, opt); diff != "" {
+			t.Errorf("call %d: loadOrBuildTriangulation(...) mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+
+	// Options and inputs are part of the key.
+	opts := []s2delaunay.TriangulationOption{s2delaunay.WithEps(1e-10)}
+	_, loaded, err := loadOrBuildTriangulation(path, vertices, FormatVersion, opts)
+	if err != nil || loaded {
+		t.Errorf("loadOrBuildTriangulation(..., WithEps) = loaded %v, %v, want rebuilt", loaded, err)
+	}
+	_, loaded, err = loadOrBuildTriangulation(path, vertices[1:], FormatVersion, opts)
+	if err != nil || loaded {
+		t.Errorf("loadOrBuildTriangulation(other vertices) = loaded %v, %v, want rebuilt", loaded,
+			err)
+	}
+}
+
+func TestLoadOrBuildDiagram(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vd.cache")
+	sites := utils.GenerateRandomPoints(100, 0)
+	opts := []s2voronoi.DiagramOption{s2voronoi.WithIDLevel(20)}
+	want, err := s2voronoi.NewDiagram(sites, opts...)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	for i, wantLoaded := range []bool{false, true} {
+		got, loaded, err := loadOrBuildDiagram(path, sites, FormatVersion, opts)
+		if err != nil {
+			t.Fatalf("call %d: loadOrBuildDiagram(...) error = %v, want nil", i, err)
+		}
+		if loaded != wantLoaded {
+			t.Errorf("call %d: loaded = %v, want %v", i, loaded, wantLoaded)
+		}
+		if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(s2voronoi.Diagram{})); diff != "" {
+			t.Errorf("call %d: loadOrBuildDiagram(...) mismatch (-want +got):\n%s", i, diff)
+		}
+		if got.CellID(0) != want.CellID(0) {
+			t.Errorf("call %d: got.CellID(0) = %#x, want %#x", i, got.CellID(0), want.CellID(0))
+		}
+	}
+
+	override := s2voronoi.WithVertexOverride(func([3]s2.Point, int) (s2.Point, bool) {
+		return s2.Point{}, false
+	})
+	if _, err := LoadOrBuildDiagram(path, sites, override); !errors.Is(err, ErrUncacheable) {
+		t.Errorf("LoadOrBuildDiagram(..., WithVertexOverride) error = %v, want ErrUncacheable",
+			err)
+	}
+}
+
+func TestLoadOrBuildDiagram_Metrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vd.cache")
+	sites := utils.GenerateRandomPoints(100, 0)
+	var first, second s2voronoi.BuildMetrics
+
+	for i, m := range []*s2voronoi.BuildMetrics{&first, &second} {
+		opts := []s2voronoi.DiagramOption{s2voronoi.WithMetrics(m)}
+		d, loaded, err := loadOrBuildDiagram(path, sites, FormatVersion, opts)
+		if err != nil {
+			t.Fatalf("call %d: loadOrBuildDiagram(...) error = %v, want nil", i, err)
+		}
+		if wantLoaded := i == 1; loaded != wantLoaded {
+			t.Errorf("call %d: loaded = %v, want %v", i, loaded, wantLoaded)
+		}
+		if m.Sites != len(sites) || m.Vertices != len(d.Vertices) {
+			t.Errorf("call %d: metrics sizes = %d, %d, want %d, %d", i, m.Sites, m.Vertices,
+				len(sites), len(d.Vertices))
+		}
+	}
+	if second.Total != 0 {
+		t.Errorf("loaded metrics Total = %v, want 0", second.Total)
+	}
+}
+
+func TestLoadOrBuild_Corruption(t *testing.T) {
+	sites := utils.GenerateRandomPoints(50, 0)
+	tests := []struct {
+		name    string
+		corrupt func(data []byte) []byte
+	}{
+		{"flipped payload byte", func(data []byte) []byte {
+			data[len(data)-5] ^= 0xff
+			return data
+		}},
+		{"truncated", func(data []byte) []byte { return data[:len(data)/2] }},
+		{"empty", func([]byte) []byte { return nil }},
+		{"bad magic", func(data []byte) []byte {
+			data[0] = 'X'
+			return data
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vd.cache")
+			if _, err := LoadOrBuildDiagram(path, sites); err != nil {
+				t.Fatalf("LoadOrBuildDiagram(...) error = %v, want nil", err)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("os.ReadFile(...) error = %v, want nil", err)
+			}
+			if err := os.WriteFile(path, tt.corrupt(data), 0o644); err != nil {
+				t.Fatalf("os.WriteFile(...) error = %v, want nil", err)
+			}
+
+			for i, wantLoaded := range []bool{false, true} {
+				_, loaded, err := loadOrBuildDiagram(path, sites, FormatVersion, nil)
+				if err != nil {
+					t.Fatalf("call %d: loadOrBuildDiagram(...) error = %v, want nil", i, err)
+				}
+				if loaded != wantLoaded {
+					t.Errorf("call %d: loaded = %v, want %v", i, loaded, wantLoaded)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadOrBuild_StaleVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dt.cache")
+	vertices := utils.GenerateRandomPoints(50, 0)
+	if _, _, err := loadOrBuildTriangulation(path, vertices, FormatVersion-1, nil); err != nil {
+		t.Fatalf("loadOrBuildTriangulation(old version) error = %v, want nil", err)
+	}
+	_, loaded, err := loadOrBuildTriangulation(path, vertices, FormatVersion, nil)
+	if err != nil {
+		t.Fatalf("loadOrBuildTriangulation(...) error = %v, want nil", err)
+	}
+	if loaded {
+		t.Errorf("loadOrBuildTriangulation(...) loaded a file written by an older version")
+	}
+}
+
+func TestLoadOrBuild_Concurrent(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "vd.cache")
+	sites := utils.GenerateRandomPoints(200, 0)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 16)
+	for g := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 5 {
+				d, err := LoadOrBuildDiagram(path, sites)
+				if err == nil && d.NumCells() != len(sites) {
+					err = errors.New("wrong cell count")
+				}
+				if err != nil {
+					errs[g] = err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for g, err := range errs {
+		if err != nil {
+			t.Errorf("goroutine %d: LoadOrBuildDiagram(...) error = %v, want nil", g, err)
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("os.ReadDir(...) error = %v, want nil", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("cache directory has %d entries, want 1 without leftover temporary files",
+			len(entries))
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Cache
+
+func TestDiagram_CellAreas_Concurrent(t *testing.T) {
+	vd := mustNewDiagram(t, 1000)
+	want := make([]float64, vd.NumCells())
+	for i := range want {
+		want[i] = Cell{idx: i, d: vd}.Loop().Area()
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]float64, 32)
+	for g := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				results[g] = vd.CellAreas()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for g, got := range results {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("goroutine %d vd.CellAreas() mismatch (-want +got):\n%s", g, diff)
+		}
+	}
+}
+
+func TestCell_Area_Concurrent(t *testing.T) {
+	vd := mustNewDiagram(t, 1000)
+	want := make([]float64, vd.NumCells())
+	for i := range want {
+		want[i] = Cell{idx: i, d: vd}.Loop().Area()
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range vd.NumCells() {
+				Cell{idx: i, d: vd}.Area()
+			}
+		}()
+	}
+	vd.PrecomputeAreas()
+	wg.Wait()
+
+	for i := range want {
+		if got := (Cell{idx: i, d: vd}).Area(); got != want[i] {
+			t.Errorf("Cell(%d).Area() = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestDiagram_InvalidateCaches(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	if err := vd.Rebuild(utils.GenerateRandomPoints(50, 1)); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	after := vd.CellAreas()
+	if len(after) != 50 {
+		t.Fatalf("len(vd.CellAreas()) = %d after rebuild, want 50", len(after))
+	}
+	total := 0.0
+	for _, a := range after {
+		total += a
+	}
+	if math.Abs(total-4*math.Pi) > 1e-9 {
+		t.Errorf("sum of vd.CellAreas() = %v, want 4π", total)
+	}
+
+	// Direct slice manipulation is only observed after an explicit invalidation.
+	vd.Vertices[vd.CellVertices[0]] = vd.Sites[0]
+	stale := vd.CellAreas()[0]
+	if got := (Cell{idx: 0, d: vd}).Area(); got != stale {
+		t.Errorf("c.Area() = %v without invalidation, want cached %v", got, stale)
+	}
+	vd.InvalidateCaches()
+	if fresh := vd.CellAreas()[0]; fresh == stale {
+		t.Errorf("vd.CellAreas()[0] = %v after InvalidateCaches, want recomputed value", fresh)
+	}
+}
+
+func TestDiagram_Warm(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	vd.Warm()
+	c := vd.caches()
+	for i := range c.areaBits {
+		if c.areaBits[i].Load() == 0 {
+			t.Errorf("area of cell %d not cached after vd.Warm()", i)
+		}
+	}
+	if len(c.cellIDs) != vd.NumCells() || c.idsErr != nil {
+		t.Errorf("cell ID index has %d entries, error %v, want %d, nil", len(c.cellIDs),
+			c.idsErr, vd.NumCells())
+	}
+}
@@ -7,11 +7,23 @@
 package s2voronoi
 
 import (
+	"cmp"
 	"fmt"
+	"math"
+	"slices"
+	"sort"
 
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 )
 
+const (
+	// minCentroidNorm is the norm of the area-weighted centroid, about the cell area in
+	// steradians, at or below which Centroid falls back to the site.
+	minCentroidNorm = 1e-24
+)
+
 // Cell represents a Voronoi cell. It is a view structure for accessing a cell in a Diagram.
 // The cell's index corresponds to the index of its site in the Diagram's Sites.
 type Cell struct {
@@ -47,7 +59,7 @@ func (c Cell) Vertex(i int) (s2.Point, error) {
 	start := c.d.CellOffsets[c.idx]
 	end := c.d.CellOffsets[c.idx+1]
 	if i < 0 || i >= end-start {
-		return s2.Point{}, fmt.Errorf("Vertex: index %d out of range [0 %d)", i, end-start)
+		return s2.Point{}, fmt.Errorf("Vertex: index %d %w [0 %d)", i, ErrOutOfRange, end-start)
 	}
 	return c.d.Vertices[c.d.CellVertices[start+i]], nil
 }
@@ -60,17 +72,25 @@ func (c Cell) NumNeighbors() int {
 
 // NeighborIndices returns the indices of the neighboring cells in the Diagram,
 // sorted in counter-clockwise order when looking out of the sphere.
+// It panics if the diagram was built WithoutNeighbors and BuildNeighbors was not called.
 func (c Cell) NeighborIndices() []int {
+	if !c.d.hasNeighbors() {
+		panic(fmt.Errorf("NeighborIndices: %w, call BuildNeighbors first", ErrNoNeighbors))
+	}
 	return c.d.CellNeighbors[c.d.CellOffsets[c.idx]:c.d.CellOffsets[c.idx+1]]
 }
 
 // Neighbor returns the neighboring cell at the specified index.
-// It returns an error if the index is out of range.
+// It returns an error if the index is out of range, or wrapping ErrNoNeighbors if the diagram
+// was built WithoutNeighbors and BuildNeighbors was not called.
 func (c Cell) Neighbor(i int) (Cell, error) {
+	if !c.d.hasNeighbors() {
+		return Cell{}, fmt.Errorf("Neighbor: %w", ErrNoNeighbors)
+	}
 	start := c.d.CellOffsets[c.idx]
 	end := c.d.CellOffsets[c.idx+1]
 	if i < 0 || i >= end-start {
-		return Cell{}, fmt.Errorf("Neighbor: index %d out of range [0 %d)", i, end-start)
+		return Cell{}, fmt.Errorf("Neighbor: index %d %w [0 %d)", i, ErrOutOfRange, end-start)
 	}
 	nc, err := c.d.Cell(c.d.CellNeighbors[start+i])
 	if err != nil {
@@ -78,3 +98,243 @@ func (c Cell) Neighbor(i int) (Cell, error) {
 	}
 	return nc, nil
 }
+
+// Area returns the area of the cell in steradians. It is computed on first use and cached on
+// the diagram until the diagram is rebuilt or its caches are invalidated.
+func (c Cell) Area() float64 {
+	return c.d.cellArea(c.idx)
+}
+
+// Perimeter returns the length of the cell boundary as an angle, the sum of the great-circle
+// distances between consecutive vertices of the ring.
+func (c Cell) Perimeter() s1.Angle {
+	indices := c.VertexIndices()
+	perimeter := s1.Angle(0)
+	for k, v := range indices {
+		perimeter += c.d.Vertices[v].Distance(c.d.Vertices[indices[(k+1)%len(indices)]])
+	}
+	return perimeter
+}
+
+// BoundaryLengthPrefix returns the cumulative lengths along the cell boundary: entry k is the
+// length of the ring from vertex 0 to vertex k, and the last of the NumVertices()+1 entries is
+// the Perimeter, summed in the same order.
+func (c Cell) BoundaryLengthPrefix() []s1.Angle {
+	indices := c.VertexIndices()
+	prefix := make([]s1.Angle, len(indices)+1)
+	for k, v := range indices {
+		next := c.d.Vertices[indices[(k+1)%len(indices)]]
+		prefix[k+1] = prefix[k] + c.d.Vertices[v].Distance(next)
+	}
+	return prefix
+}
+
+// BoundaryPoint returns the point at fraction t of the Perimeter along the cell boundary,
+// starting at ring vertex 0 and following the ring, so that uniformly spaced t are uniformly
+// spaced in arc length. t is taken modulo 1, and t = 0 gives Vertex(0). A t landing exactly on
+// a vertex gives that vertex, and zero-length edges between coincident vertices are never
+// interpolated.
+func (c Cell) BoundaryPoint(t float64) s2.Point {
+	return c.boundaryPointAt(c.BoundaryLengthPrefix(), t)
+}
+
+// boundaryPointAt is BoundaryPoint with the prefix returned by BoundaryLengthPrefix for the
+// cell.
+func (c Cell) boundaryPointAt(prefix []s1.Angle, t float64) s2.Point {
+	indices := c.VertexIndices()
+	perimeter := prefix[len(indices)]
+	s := s1.Angle(t-math.Floor(t)) * perimeter
+	// Find the edge k with prefix[k] <= s < prefix[k+1], which skips zero-length edges.
+	k := sort.Search(len(indices), func(k int) bool { return prefix[k+1] > s })
+	if k == len(indices) {
+		return c.d.Vertices[indices[0]]
+	}
+	a, b := c.d.Vertices[indices[k]], c.d.Vertices[indices[(k+1)%len(indices)]]
+	if s == prefix[k] {
+		return a
+	}
+	return s2.InterpolateAtDistance(s-prefix[k], a, b)
+}
+
+// NeighborDistances returns the angular distance from the site to the site of each neighbor,
+// in NeighborIndices order.
+// It panics like NeighborIndices if the diagram has no neighbors.
+func (c Cell) NeighborDistances() []s1.Angle {
+	site := c.Site()
+	neighbors := c.NeighborIndices()
+	distances := make([]s1.Angle, len(neighbors))
+	for i, n := range neighbors {
+		distances[i] = site.Distance(c.d.Sites[n])
+	}
+	return distances
+}
+
+// Centroid returns the area-weighted centroid of the cell on the sphere, the centroid of the
+// spherical polygon projected back onto the unit sphere. It lies at the center of a symmetric
+// cell, such as the square cells of the six sites of an octahedron.
+// When the cell has collapsed to near-zero area, or is so large that the weighted centroid
+// nearly vanishes, the direction is not meaningful and Centroid returns the site instead.
+func (c Cell) Centroid() s2.Point {
+	centroid := c.Loop().Centroid()
+	if n := centroid.Norm(); n <= minCentroidNorm || math.IsNaN(n) {
+		return c.Site()
+	}
+	return s2.Point{Vector: centroid.Normalize()}
+}
+
+// SeparatingPlanes returns, for each neighbor in NeighborIndices order, the unit normal of the
+// great-circle plane bisecting the site and the neighbor, oriented so the site is on the
+// positive side. A point is in the cell iff it is on the positive side of every plane.
+// It panics like NeighborIndices if the diagram has no neighbors.
+func (c Cell) SeparatingPlanes() []r3.Vector {
+	site := c.Site()
+	neighbors := c.NeighborIndices()
+	planes := make([]r3.Vector, len(neighbors))
+	for i, n := range neighbors {
+		planes[i] = site.Sub(c.d.Sites[n].Vector).Normalize()
+	}
+	return planes
+}
+
+// ExtentToward returns the angular distance from the site to the cell boundary along the great
+// circle leaving the site in direction dir. Only the component of dir tangent to the sphere at
+// the site is used.
+// It returns an error if dir has no tangent component, or wrapping ErrNoNeighbors if the
+// diagram was built WithoutNeighbors and BuildNeighbors was not called.
+func (c Cell) ExtentToward(dir r3.Vector) (s1.Angle, error) {
+	if !c.d.hasNeighbors() {
+		return 0, fmt.Errorf("ExtentToward: %w", ErrNoNeighbors)
+	}
+	site := c.Site()
+	tangent := dir.Sub(site.Mul(dir.Dot(site.Vector)))
+	if tangent.Norm2() == 0 {
+		return 0, fmt.Errorf("ExtentToward: direction %v has no component tangent to the site",
+			dir)
+	}
+	tangent = tangent.Normalize()
+
+	// Along p(θ) = site cos θ + tangent sin θ, the signed distance a cos θ + b sin θ to a
+	// separating plane starts positive and first vanishes at θ = atan2(a, -b) in (0, π).
+	extent := math.Pi
+	for _, m := range c.SeparatingPlanes() {
+		extent = min(extent, math.Atan2(m.Dot(site.Vector), -m.Dot(tangent)))
+	}
+	return s1.Angle(extent), nil
+}
+
+// Contains reports whether p lies in the cell, testing it against the spherical polygon of
+// the cell vertices rather than searching for the nearest site, so cells spanning a pole or
+// more than a hemisphere are handled. It agrees with NearestSite away from the boundary.
+// A point exactly on the boundary follows the semi-open model of s2.Loop: since adjacent cells
+// share their vertices, a point on an edge or at a vertex is contained in exactly one of the
+// cells meeting there, though not necessarily the one NearestSite returns.
+func (c Cell) Contains(p s2.Point) bool {
+	return c.Loop().ContainsPoint(p)
+}
+
+// Loop returns the cell boundary as an s2.Loop whose interior is the cell, for use with the
+// rest of the s2 package. The ring of VertexIndices is CCW when looking out of the sphere,
+// which keeps the cell on the right of each edge, while s2 keeps the interior on the left, so
+// the loop lists the vertices in reverse ring order.
+func (c Cell) Loop() *s2.Loop {
+	indices := c.VertexIndices()
+	n := len(indices)
+	points := make([]s2.Point, n)
+	for i, vIdx := range indices {
+		points[n-1-i] = c.d.Vertices[vIdx]
+	}
+	return s2.LoopFromPoints(points)
+}
+
+// Rings returns the cell boundary as one or more rings oriented like VertexIndices.
+// A cell within the open hemisphere centered at its site is returned as a single ring.
+// A larger cell is split along two orthogonal great circles through its site into four rings,
+// each starting at the site and spanning a quarter turn around it, so every ring lies within
+// a hemisphere and maps to a simple planar ring under gnomonic projection.
+// It returns an error if the boundary of a larger cell does not cross each of the four rays
+// exactly once, as happens for a degenerate ring.
+func (c Cell) Rings() ([][]s2.Point, error) {
+	indices := c.VertexIndices()
+	ring := make([]s2.Point, len(indices))
+	site := c.Site()
+	wrap := false
+	for i, vIdx := range indices {
+		ring[i] = c.d.Vertices[vIdx]
+		if ring[i].Dot(site.Vector) <= 0 {
+			wrap = true
+		}
+	}
+	if !wrap {
+		return [][]s2.Point{ring}, nil
+	}
+
+	u := s2.Ortho(site)
+	w := site.Cross(u.Vector).Normalize()
+	rays := [4]r3.Vector{u.Vector, w, u.Mul(-1), w.Mul(-1)}
+
+	// Insert the points where the rays from the site cross the boundary into the ring.
+	type ringPoint struct {
+		p   s2.Point
+		ray bool
+	}
+	numPoints := len(ring)
+	augmented := make([]ringPoint, 0, numPoints+len(rays))
+	numRays := 0
+	for i := range numPoints {
+		a, b := ring[i], ring[(i+1)%numPoints]
+		var crossings []ringPoint
+		onRay := false
+		for _, d := range rays {
+			n := site.Cross(d)
+			da, db := n.Dot(a.Vector), n.Dot(b.Vector)
+			// A vertex on the great circle of d is either on the ray, and becomes a ray point
+			// itself, or on the opposite ray, where the edge crosses no ray of this circle.
+			if da == 0 {
+				if a.Dot(d) > 0 {
+					onRay = true
+				}
+				continue
+			}
+			if (da > 0) == (db > 0) || db == 0 {
+				continue
+			}
+			x := b.Mul(da).Sub(a.Mul(db))
+			if da < 0 {
+				x = x.Mul(-1)
+			}
+			if x.Dot(d) > 0 {
+				crossings = append(crossings, ringPoint{s2.Point{Vector: x.Normalize()}, true})
+			}
+		}
+		augmented = append(augmented, ringPoint{a, onRay})
+		slices.SortFunc(crossings, func(x, y ringPoint) int {
+			return cmp.Compare(a.Distance(x.p), a.Distance(y.p))
+		})
+		augmented = append(augmented, crossings...)
+	}
+	start := -1
+	for i, rp := range augmented {
+		if rp.ray {
+			numRays++
+			if start < 0 {
+				start = i
+			}
+		}
+	}
+	if numRays != len(rays) {
+		return nil, fmt.Errorf("Rings: boundary of cell %d crosses the split rays %d times, "+
+			"want %d", c.idx, numRays, len(rays))
+	}
+
+	rings := make([][]s2.Point, 0, len(rays))
+	part := []s2.Point{site, augmented[start].p}
+	for k := 1; k <= len(augmented); k++ {
+		rp := augmented[(start+k)%len(augmented)]
+		part = append(part, rp.p)
+		if rp.ray {
+			rings = append(rings, part)
+			part = []s2.Point{site, rp.p}
+		}
+	}
+	return rings, nil
+}
@@ -5,8 +5,11 @@
 package s2voronoi
 
 import (
+	"math"
 	"testing"
 
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -149,3 +152,441 @@ func TestCell_Neighbor(t *testing.T) {
 		}
 	}
 }
+
+func TestCell_SeparatingPlanes(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	const eps = 1e-12
+	for i := range vd.NumCells() {
+		c, _ := vd.Cell(i)
+		planes := c.SeparatingPlanes()
+		if len(planes) != c.NumNeighbors() {
+			t.Fatalf("len(c.SeparatingPlanes()) = %d, want %d", len(planes), c.NumNeighbors())
+		}
+		for k, n := range planes {
+			if n.Dot(c.Site().Vector) <= 0 {
+				t.Errorf("cell %d: site on negative side of plane %d", i, k)
+			}
+			// Vertex k is shared by the edges to neighbors k-1 and k.
+			v, _ := c.Vertex(k)
+			prev := planes[(k+len(planes)-1)%len(planes)]
+			if math.Abs(n.Dot(v.Vector)) > eps || math.Abs(prev.Dot(v.Vector)) > eps {
+				t.Errorf("cell %d: vertex %d not on planes %d and %d", i, k, k-1, k)
+			}
+		}
+	}
+
+	for j, p := range utils.GenerateRandomPoints(1000, 1) {
+		want := nearestSiteBruteForce(vd, p)
+		for i := range vd.NumCells() {
+			c, _ := vd.Cell(i)
+			inside := true
+			for _, n := range c.SeparatingPlanes() {
+				if n.Dot(p.Vector) <= 0 {
+					inside = false
+				}
+			}
+			if inside != (i == want) {
+				t.Errorf("points[%d] inside cell %d = %v, want %v", j, i, inside, i == want)
+			}
+		}
+	}
+}
+
+func TestCell_ExtentToward(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	for i := range vd.NumCells() {
+		c, _ := vd.Cell(i)
+		site := c.Site()
+		// The boundary is first reached at a vertex when heading straight for it.
+		for k := range c.NumVertices() {
+			v, _ := c.Vertex(k)
+			got, err := c.ExtentToward(v.Sub(site.Vector))
+			if err != nil {
+				t.Fatalf("c.ExtentToward(...) error = %v, want nil", err)
+			}
+			if want := site.Distance(v); math.Abs(float64(got-want)) > 1e-9 {
+				t.Errorf("cell %d: c.ExtentToward(vertex %d) = %v, want %v", i, k, got, want)
+			}
+		}
+
+		dir := s2.Ortho(site)
+		got, err := c.ExtentToward(dir.Vector)
+		if err != nil {
+			t.Fatalf("c.ExtentToward(...) error = %v, want nil", err)
+		}
+		before := s2.InterpolateAtDistance(got-1e-9, site, s2.Point{Vector: dir.Vector})
+		after := s2.InterpolateAtDistance(got+1e-9, site, s2.Point{Vector: dir.Vector})
+		if n := nearestSiteBruteForce(vd, before); n != i {
+			t.Errorf("cell %d: point before boundary nearest to site %d", i, n)
+		}
+		if n := nearestSiteBruteForce(vd, after); n == i {
+			t.Errorf("cell %d: point after boundary nearest to site %d", i, n)
+		}
+	}
+
+	c, _ := vd.Cell(0)
+	if _, err := c.ExtentToward(c.Site().Mul(2)); err == nil {
+		t.Errorf("c.ExtentToward(site) error = nil, want non-nil")
+	}
+}
+
+func TestCell_Contains(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	for i, p := range utils.GenerateRandomPoints(500, 1) {
+		want := vd.NearestSite(p)
+		for j := range vd.NumCells() {
+			if got := (Cell{idx: j, d: vd}).Contains(p); got != (j == want) {
+				t.Errorf("point %d: cell %d Contains = %v, want %v", i, j, got, j == want)
+			}
+		}
+	}
+
+	// Every Voronoi vertex belongs to exactly one of the cells meeting there.
+	count := make([]int, len(vd.Vertices))
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		for _, vIdx := range c.VertexIndices() {
+			if c.Contains(vd.Vertices[vIdx]) {
+				count[vIdx]++
+			}
+		}
+	}
+	for vIdx, n := range count {
+		if n != 1 {
+			t.Errorf("vertex %d is contained in %d cells, want 1", vIdx, n)
+		}
+	}
+}
+
+func TestCell_Contains_Large(t *testing.T) {
+	// A few sites give cells spanning more than a hemisphere.
+	vd := mustNewDiagram(t, 5)
+	for i, p := range utils.GenerateRandomPoints(500, 2) {
+		want := vd.NearestSite(p)
+		if !(Cell{idx: want, d: vd}).Contains(p) {
+			t.Errorf("point %d: nearest cell %d does not contain it", i, want)
+		}
+	}
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		antipode := s2.Point{Vector: c.Site().Mul(-1)}
+		if c.Contains(antipode) != (vd.NearestSite(antipode) == i) {
+			t.Errorf("cell %d: Contains(antipode) disagrees with NearestSite", i)
+		}
+	}
+}
+
+func TestCell_Loop(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	total := 0.0
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		l := c.Loop()
+		if err := l.Validate(); err != nil {
+			t.Errorf("cell %d: c.Loop().Validate() error = %v, want nil", i, err)
+		}
+		if !l.ContainsPoint(c.Site()) {
+			t.Errorf("cell %d: c.Loop() does not contain the site", i)
+		}
+		if l.NumVertices() != c.NumVertices() {
+			t.Errorf("cell %d: c.Loop() has %d vertices, want %d", i, l.NumVertices(),
+				c.NumVertices())
+		}
+		total += l.Area()
+	}
+	if math.Abs(total-4*math.Pi) > 1e-9 {
+		t.Errorf("total loop area = %v, want %v", total, 4*math.Pi)
+	}
+}
+
+func TestCell_Centroid(t *testing.T) {
+	octahedron := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(-1, 0, 0),
+		s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(0, -1, 0),
+		s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1),
+	}
+	vd, err := NewDiagram(octahedron)
+	if err != nil {
+		t.Fatalf("NewDiagram(octahedron) error = %v, want nil", err)
+	}
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		if got := c.Centroid(); got.Distance(c.Site()) > 1e-12 {
+			t.Errorf("Cell(%d).Centroid() = %v, want site %v", i, got, c.Site())
+		}
+	}
+
+	vd = mustNewDiagram(t, 200)
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		got := c.Centroid()
+		if math.Abs(got.Norm()-1) > 1e-15 {
+			t.Errorf("Cell(%d).Centroid() norm = %v, want 1", i, got.Norm())
+		}
+		if !c.Contains(got) {
+			t.Errorf("Cell(%d).Centroid() = %v, want inside the cell", i, got)
+		}
+	}
+
+	// A cell collapsed onto a single point falls back to its site.
+	for _, v := range (Cell{idx: 0, d: vd}).VertexIndices() {
+		vd.Vertices[v] = vd.Vertices[vd.CellVertices[0]]
+	}
+	if got, want := (Cell{idx: 0, d: vd}).Centroid(), vd.Sites[0]; got != want {
+		t.Errorf("Cell(0).Centroid() = %v for a collapsed cell, want site %v", got, want)
+	}
+}
+
+func TestCell_Perimeter(t *testing.T) {
+	octahedron := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(-1, 0, 0),
+		s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(0, -1, 0),
+		s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1),
+	}
+	vd, err := NewDiagram(octahedron)
+	if err != nil {
+		t.Fatalf("NewDiagram(octahedron) error = %v, want nil", err)
+	}
+	// Each cell is a square whose edges join adjacent cube diagonals.
+	edge := math.Acos(1.0 / 3)
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		if got := c.Perimeter().Radians(); math.Abs(got-4*edge) > 1e-12 {
+			t.Errorf("Cell(%d).Perimeter() = %v, want %v", i, got, 4*edge)
+		}
+		distances := c.NeighborDistances()
+		if len(distances) != c.NumNeighbors() {
+			t.Fatalf("len(Cell(%d).NeighborDistances()) = %d, want %d", i, len(distances),
+				c.NumNeighbors())
+		}
+		for k, d := range distances {
+			if math.Abs(d.Radians()-math.Pi/2) > 1e-12 {
+				t.Errorf("Cell(%d).NeighborDistances()[%d] = %v, want π/2", i, k, d.Radians())
+			}
+		}
+	}
+}
+
+func TestCell_BoundaryPoint(t *testing.T) {
+	vd := mustNewDiagram(t, 50)
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		prefix := c.BoundaryLengthPrefix()
+		if len(prefix) != c.NumVertices()+1 || prefix[0] != 0 {
+			t.Fatalf("Cell(%d).BoundaryLengthPrefix() = %v, want %d entries from 0", i, prefix,
+				c.NumVertices()+1)
+		}
+		if got, want := prefix[len(prefix)-1], c.Perimeter(); got != want {
+			t.Errorf("Cell(%d) prefix total = %v, want Perimeter %v", i, got, want)
+		}
+		v0, _ := c.Vertex(0)
+		for _, tt := range []float64{0, 1, -1} {
+			if got := c.BoundaryPoint(tt); got != v0 {
+				t.Errorf("Cell(%d).BoundaryPoint(%v) = %v, want Vertex(0) %v", i, tt, got, v0)
+			}
+		}
+		for k := 1; k < c.NumVertices(); k++ {
+			vk, _ := c.Vertex(k)
+			tk := float64(prefix[k] / prefix[len(prefix)-1])
+			if got := c.BoundaryPoint(tk); got.Distance(vk) > 1e-14 {
+				t.Errorf("Cell(%d).BoundaryPoint(%v) = %v, want Vertex(%d) %v", i, tk, got, k, vk)
+			}
+		}
+
+		// Uniform steps in t advance uniformly along the boundary, so consecutive points are
+		// one step apart except across a vertex, where the chord is shorter.
+		const steps = 200
+		step := prefix[len(prefix)-1] / steps
+		prev := c.BoundaryPoint(0)
+		for j := 1; j <= steps; j++ {
+			p := c.BoundaryPoint(float64(j) / steps)
+			if d := prev.Distance(p); d > step+1e-12 {
+				t.Errorf("Cell(%d) step %d = %v, want at most %v", i, j, d, step)
+			}
+			prev = p
+		}
+		if prev.Distance(v0) > 1e-14 {
+			t.Errorf("Cell(%d).BoundaryPoint(1) = %v, want Vertex(0) %v", i, prev, v0)
+		}
+	}
+}
+
+func TestCell_BoundaryPoint_Octahedron(t *testing.T) {
+	octahedron := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(-1, 0, 0),
+		s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(0, -1, 0),
+		s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1),
+	}
+	vd, err := NewDiagram(octahedron)
+	if err != nil {
+		t.Fatalf("NewDiagram(octahedron) error = %v, want nil", err)
+	}
+	c := Cell{idx: 0, d: vd}
+	// The midpoints of the four equal edges lie at t = 1/8, 3/8, 5/8 and 7/8.
+	for k := range 4 {
+		a, _ := c.Vertex(k)
+		b, _ := c.Vertex((k + 1) % 4)
+		want := s2.Interpolate(0.5, a, b)
+		if got := c.BoundaryPoint(float64(2*k+1) / 8); got.Distance(want) > 1e-14 {
+			t.Errorf("BoundaryPoint(%d/8) = %v, want %v", 2*k+1, got, want)
+		}
+	}
+}
+
+func TestCell_Area_Slivers(t *testing.T) {
+	// Sites alternating just above and below the equator give long thin cells and sliver
+	// triangles between nearly collinear sites.
+	var sites s2.PointVector
+	for i := range 64 {
+		lat := 1e-7
+		if i%2 == 1 {
+			lat = -lat
+		}
+		sites = append(sites, s2.PointFromLatLng(s2.LatLngFromDegrees(lat, float64(i)*360/64)))
+	}
+	sites = append(sites, s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1))
+	vd, err := NewDiagram(sites)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	areas := vd.CellAreas()
+	total := 0.0
+	for i, a := range areas {
+		if a < 0 || math.IsNaN(a) {
+			t.Errorf("cell %d: area = %v, want non-negative", i, a)
+		}
+		if got := (Cell{idx: i, d: vd}).Area(); got != a {
+			t.Errorf("Cell(%d).Area() = %v, want %v", i, got, a)
+		}
+		total += a
+	}
+	if math.Abs(total-4*math.Pi) > 1e-9 {
+		t.Errorf("sum of vd.CellAreas() = %v, want %v", total, 4*math.Pi)
+	}
+}
+
+func TestCell_Rings(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	for i := range vd.NumCells() {
+		c, err := vd.Cell(i)
+		if err != nil {
+			t.Fatalf("vd.Cell(%d) error = %v, want nil", i, err)
+		}
+		rings, err := c.Rings()
+		if err != nil {
+			t.Fatalf("c.Rings() error = %v, want nil (cell %d)", err, i)
+		}
+		if len(rings) != 1 {
+			t.Fatalf("c.Rings() len = %d, want 1 (cell %d)", len(rings), i)
+		}
+		want := make([]s2.Point, c.NumVertices())
+		for j := range want {
+			want[j], _ = c.Vertex(j)
+		}
+		if diff := cmp.Diff(want, rings[0]); diff != "" {
+			t.Errorf("c.Rings()[0] mismatch (-want +got, cell %d):\n%s", i, diff)
+		}
+	}
+}
+
+func TestCell_Rings_Wrap(t *testing.T) {
+	// A single cell around the north pole reaching past the equator.
+	vd := &Diagram{
+		Sites: s2.PointVector{s2.PointFromCoords(0, 0, 1)},
+		Vertices: s2.PointVector{
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-20, 0)),
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-20, -90)),
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-20, 180)),
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-20, 90)),
+		},
+		CellVertices: []int{0, 1, 2, 3},
+		CellOffsets:  []int{0, 4},
+	}
+	c, err := vd.Cell(0)
+	if err != nil {
+		t.Fatalf("vd.Cell(0) error = %v, want nil", err)
+	}
+
+	checkSplitRings(t, c)
+}
+
+func TestCell_Rings_VertexOnRay(t *testing.T) {
+	// Two opposite vertices lie exactly on the split rays u and -u, so each must become a ray
+	// point once rather than also producing a crossing on the opposite ray.
+	site := s2.PointFromCoords(0, 0, 1)
+	u := s2.Ortho(site)
+	lng := s2.LatLngFromPoint(u).Lng.Degrees()
+	vd := &Diagram{
+		Sites: s2.PointVector{site},
+		Vertices: s2.PointVector{
+			u,
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-20, lng-90)),
+			{Vector: u.Mul(-1)},
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-20, lng+90)),
+		},
+		CellVertices: []int{0, 1, 2, 3},
+		CellOffsets:  []int{0, 4},
+	}
+	c, err := vd.Cell(0)
+	if err != nil {
+		t.Fatalf("vd.Cell(0) error = %v, want nil", err)
+	}
+	checkSplitRings(t, c)
+}
+
+func TestCell_Rings_OverHemisphere(t *testing.T) {
+	// Overriding the vertices around the north site pushes its cell past the equator.
+	north := s2.PointFromCoords(0, 0, 1)
+	override := func(tri [3]s2.Point, _ int) (s2.Point, bool) {
+		if tri[0] != north && tri[1] != north && tri[2] != north {
+			return s2.Point{}, false
+		}
+		center := tri[0].Add(tri[1].Vector).Add(tri[2].Vector)
+		lng := math.Atan2(center.Y, center.X) * 180 / math.Pi
+		return s2.PointFromLatLng(s2.LatLngFromDegrees(-20, lng)), true
+	}
+	for n := 4; n <= 6; n++ {
+		sites := s2.PointVector{north}
+		for i := 1; i < n; i++ {
+			lat, lng := -60-float64(i), float64(360*i/(n-1))
+			sites = append(sites, s2.PointFromLatLng(s2.LatLngFromDegrees(lat, lng)))
+		}
+		vd, err := NewDiagram(sites, WithVertexOverride(override),
+			WithOverrideTolerance(math.Pi))
+		if err != nil {
+			t.Fatalf("NewDiagram(%d sites) error = %v, want nil", n, err)
+		}
+		c, err := vd.Cell(0)
+		if err != nil {
+			t.Fatalf("vd.Cell(0) error = %v, want nil", err)
+		}
+		checkSplitRings(t, c)
+	}
+}
+
+// checkSplitRings checks that c.Rings splits c into four rings starting at the site, each
+// within a hemisphere, that together cover the cell.
+func checkSplitRings(t *testing.T, c Cell) {
+	t.Helper()
+	rings, err := c.Rings()
+	if err != nil {
+		t.Fatalf("c.Rings() error = %v, want nil", err)
+	}
+	if len(rings) != 4 {
+		t.Fatalf("c.Rings() len = %d, want 4", len(rings))
+	}
+	total := 0.0
+	for i, ring := range rings {
+		if ring[0] != c.Site() {
+			t.Errorf("c.Rings()[%d][0] = %v, want site %v", i, ring[0], c.Site())
+		}
+		area := ringArea(ring)
+		if area <= 0 || area >= 2*math.Pi {
+			t.Errorf("c.Rings()[%d] area = %v, want in (0, 2π)", i, area)
+		}
+		total += area
+	}
+	if want := c.Loop().Area(); math.Abs(total-want) > 1e-9 {
+		t.Errorf("c.Rings() total area = %v, want %v", total, want)
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/2dChan/s2voronoi/internal/checksum"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	defaultCheckAreaTolerance     = 1e-9
+	defaultCheckDistanceTolerance = s1.Angle(1e-9)
+	defaultCheckSamples           = 1000
+	defaultCheckConvexTolerance   = s1.Angle(1e-9)
+	// maxReportedIndices bounds the offending indices listed per check by Report.String.
+	maxReportedIndices = 10
+)
+
+// CheckOptions holds configuration options for Check.
+type CheckOptions struct {
+	// AreaTolerance bounds the deviation of the total cell area from 4π, in steradians.
+	AreaTolerance float64
+	// DistanceTolerance bounds the spread of the distances from a Voronoi vertex to its sites
+	// and the distance by which a located cell may be farther than the nearest site.
+	DistanceTolerance s1.Angle
+	// ConvexTolerance bounds the turn away from the site accepted at a ring vertex.
+	ConvexTolerance s1.Angle
+	// Samples is the number of random points used to cross-check point location.
+	Samples int
+	// Seed seeds the random sample points.
+	Seed int64
+	// SiteChecksum enables the check that the sites were not modified since the diagram was
+	// built.
+	SiteChecksum bool
+}
+
+// CheckOption is a functional option type for Check configuration.
+type CheckOption func(*CheckOptions) error
+
+// WithCheckAreaTolerance sets the tolerance of the area closure check in steradians.
+// It must be positive.
+func WithCheckAreaTolerance(tol float64) CheckOption {
+	return func(o *CheckOptions) error {
+		if tol <= 0 {
+			return &OptionError{Name: "WithCheckAreaTolerance",
+				Err: fmt.Errorf("%w: tol must be positive got %v", ErrInvalidOption, tol)}
+		}
+		o.AreaTolerance = tol
+		return nil
+	}
+}
+
+// WithCheckDistanceTolerance sets the tolerance of the equidistance and location checks.
+// It must be positive.
+func WithCheckDistanceTolerance(tol s1.Angle) CheckOption {
+	return func(o *CheckOptions) error {
+		if tol <= 0 {
+			return &OptionError{Name: "WithCheckDistanceTolerance",
+				Err: fmt.Errorf("%w: tol must be positive got %v", ErrInvalidOption, tol)}
+		}
+		o.DistanceTolerance = tol
+		return nil
+	}
+}
+
+// WithCheckConvexTolerance sets the tolerance of the convexity check. It must be positive.
+func WithCheckConvexTolerance(tol s1.Angle) CheckOption {
+	return func(o *CheckOptions) error {
+		if tol <= 0 {
+			return &OptionError{Name: "WithCheckConvexTolerance",
+				Err: fmt.Errorf("%w: tol must be positive got %v", ErrInvalidOption, tol)}
+		}
+		o.ConvexTolerance = tol
+		return nil
+	}
+}
+
+// WithCheckSamples sets the number of random points and their seed used to cross-check point
+// location. Zero samples skip the check. It must not be negative.
+func WithCheckSamples(n int, seed int64) CheckOption {
+	return func(o *CheckOptions) error {
+		if n < 0 {
+			return &OptionError{Name: "WithCheckSamples",
+				Err: fmt.Errorf("%w: n must not be negative got %d", ErrInvalidOption, n)}
+		}
+		o.Samples, o.Seed = n, seed
+		return nil
+	}
+}
+
+// WithCheckSiteChecksum enables the sites check, which compares a checksum of the site
+// coordinates with the one recorded when the diagram was built, rebuilt or decoded, to detect
+// modification of Sites from outside the package.
+func WithCheckSiteChecksum() CheckOption {
+	return func(o *CheckOptions) error {
+		o.SiteChecksum = true
+		return nil
+	}
+}
+
+// CheckResult is the outcome of one validation performed by Check.
+type CheckResult struct {
+	// Name identifies the check.
+	Name string
+	// Passed reports whether the check succeeded. Skipped checks pass.
+	Passed bool
+	// Skipped reports that the check does not apply or could not run.
+	Skipped bool
+	// Residual is the worst deviation found, in the unit of the check.
+	Residual float64
+	// Offending lists the cells, vertices or sample points that failed, by index.
+	Offending []int
+	// Detail describes the check or why it was skipped.
+	Detail string
+}
+
+// Report is the outcome of Check.
+type Report struct {
+	// Results holds one result per check, in the order they ran.
+	Results []CheckResult
+	// Err reports an invalid option, in which case no check ran.
+	Err error
+}
+
+// OK reports whether every check passed.
+func (r Report) OK() bool {
+	if r.Err != nil {
+		return false
+	}
+	for _, c := range r.Results {
+		if !c.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// String renders the report as one line per check, suitable for bug reports.
+func (r Report) String() string {
+	if r.Err != nil {
+		return "check: " + r.Err.Error()
+	}
+	var b strings.Builder
+	for _, c := range r.Results {
+		status := "FAIL"
+		switch {
+		case c.Skipped:
+			status = "SKIP"
+		case c.Passed:
+			status = "ok"
+		}
+		fmt.Fprintf(&b, "%-4s %-12s residual %.3g", status, c.Name, c.Residual)
+		if len(c.Offending) > 0 {
+			shown := c.Offending[:min(len(c.Offending), maxReportedIndices)]
+			fmt.Fprintf(&b, ", %d offending %v", len(c.Offending), shown)
+			if len(shown) < len(c.Offending) {
+				b.WriteString("...")
+			}
+		}
+		if c.Detail != "" {
+			b.WriteString(": " + c.Detail)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Check validates the diagram and reports the outcome of every check rather than stopping at
+// the first failure:
+//   - structure: array sizes, index ranges and unit norms, as required by UnmarshalBinary;
+//   - area: the cell areas sum to 4π;
+//   - rings: each cell edge is shared by the neighbor it is listed against;
+//   - simple: no two edges of a cell ring cross and, unless the diagram is BarycentricDual,
+//     every ring turns around its site;
+//   - convex: every cell IsConvex within the tolerance, skipped for BarycentricDual diagrams;
+//   - symmetry: every neighbor of a cell lists the cell as a neighbor;
+//   - equidistance: every Voronoi vertex is equidistant from the sites of its three cells,
+//     skipped for BarycentricDual diagrams;
+//   - locate: random points are located in their nearest cell, and its loop contains them,
+//     skipped for BarycentricDual diagrams;
+//   - sites: under WithCheckSiteChecksum, the sites match the checksum recorded at build time.
+//
+// The geometric checks are skipped when the structure check fails, and the rings and symmetry
+// checks when the diagram was built WithoutNeighbors.
+func Check(d *Diagram, setters ...CheckOption) Report {
+	opts := CheckOptions{
+		AreaTolerance:     defaultCheckAreaTolerance,
+		DistanceTolerance: defaultCheckDistanceTolerance,
+		ConvexTolerance:   defaultCheckConvexTolerance,
+		Samples:           defaultCheckSamples,
+	}
+	for _, set := range setters {
+		err := set(&opts)
+		if err != nil {
+			return Report{Err: err}
+		}
+	}
+
+	structure := CheckResult{Name: "structure", Passed: true}
+	if err := d.checkStructure(); err != nil {
+		structure.Passed = false
+		structure.Detail = err.Error()
+	}
+	r := Report{Results: []CheckResult{structure}}
+	if !structure.Passed {
+		names := []string{"area", "rings", "simple", "convex", "symmetry", "equidistance",
+			"locate"}
+		for _, name := range names {
+			r.Results = append(r.Results, CheckResult{Name: name, Passed: true, Skipped: true,
+				Detail: "invalid structure"})
+		}
+	} else {
+		r.Results = append(r.Results, d.checkArea(opts), d.checkRings(), d.checkSimple(),
+			d.checkConvex(opts), d.checkSymmetry(), d.checkEquidistance(opts), d.checkLocate(opts))
+	}
+	if opts.SiteChecksum {
+		r.Results = append(r.Results, d.checkSites())
+	}
+	return r
+}
+
+// checkSites compares the checksum of the sites with the one recorded by recordSites.
+func (d *Diagram) checkSites() CheckResult {
+	if checksum.Points(d.Sites) == d.sitesHash {
+		return CheckResult{Name: "sites", Passed: true}
+	}
+	return CheckResult{Name: "sites", Residual: 1,
+		Detail: "sites were modified after the diagram was built"}
+}
+
+// recordSites records the checksum of the current sites for the sites check.
+func (d *Diagram) recordSites() {
+	d.sitesHash = checksum.Points(d.Sites)
+}
+
+// checkArea compares the total cell area with the area of the sphere.
+func (d *Diagram) checkArea(opts CheckOptions) CheckResult {
+	total := 0.0
+	for _, a := range d.CellAreas() {
+		total += a
+	}
+	res := math.Abs(total - 4*math.Pi)
+	return CheckResult{Name: "area", Passed: res <= opts.AreaTolerance, Residual: res}
+}
+
+// checkRings verifies that the edge from vertex k to vertex k+1 of each cell is also an edge of
+// neighbor k, and reports the offending cells.
+func (d *Diagram) checkRings() CheckResult {
+	out := CheckResult{Name: "rings"}
+	if !d.hasNeighbors() {
+		out.Passed, out.Skipped = true, true
+		out.Detail = "no neighbors"
+		return out
+	}
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}
+		vertices, neighbors := c.VertexIndices(), c.NeighborIndices()
+		n := len(vertices)
+		for k, nb := range neighbors {
+			other := Cell{idx: nb, d: d}.VertexIndices()
+			if !slices.Contains(other, vertices[k]) || !slices.Contains(other, vertices[(k+1)%n]) {
+				out.Offending = append(out.Offending, i)
+				break
+			}
+		}
+	}
+	out.Residual = float64(len(out.Offending))
+	out.Passed = len(out.Offending) == 0
+	return out
+}
+
+// checkSimple verifies with ringSimple that every cell ring is simple, and reports the
+// offending cells.
+func (d *Diagram) checkSimple() CheckResult {
+	out := CheckResult{Name: "simple"}
+	for i := range d.NumCells() {
+		if !d.ringSimple(i) {
+			out.Offending = append(out.Offending, i)
+		}
+	}
+	out.Residual = float64(len(out.Offending))
+	out.Passed = len(out.Offending) == 0
+	return out
+}
+
+// checkConvex reports the non-convex cells, detailing the first reflex vertex, and the worst
+// reflex turn in radians as the residual.
+func (d *Diagram) checkConvex(opts CheckOptions) CheckResult {
+	out := CheckResult{Name: "convex"}
+	if d.Dual == BarycentricDual {
+		out.Passed, out.Skipped = true, true
+		out.Detail = "barycentric cells need not be convex"
+		return out
+	}
+	for i := range d.NumCells() {
+		r, ok := Cell{idx: i, d: d}.FirstReflex(opts.ConvexTolerance)
+		if !ok {
+			continue
+		}
+		if len(out.Offending) == 0 {
+			out.Detail = "first reflex at " + r.String()
+		}
+		out.Offending = append(out.Offending, i)
+		out.Residual = max(out.Residual, -r.Turn.Radians())
+	}
+	out.Passed = len(out.Offending) == 0
+	return out
+}
+
+// checkSymmetry verifies that the neighbor relation is symmetric and reports the offending
+// cells.
+func (d *Diagram) checkSymmetry() CheckResult {
+	out := CheckResult{Name: "symmetry"}
+	if !d.hasNeighbors() {
+		out.Passed, out.Skipped = true, true
+		out.Detail = "no neighbors"
+		return out
+	}
+	for i := range d.NumCells() {
+		for _, nb := range (Cell{idx: i, d: d}).NeighborIndices() {
+			if nb == i || !slices.Contains(Cell{idx: nb, d: d}.NeighborIndices(), i) {
+				out.Offending = append(out.Offending, i)
+				break
+			}
+		}
+	}
+	out.Residual = float64(len(out.Offending))
+	out.Passed = len(out.Offending) == 0
+	return out
+}
+
+// checkEquidistance measures the spread of the distances from each Voronoi vertex to the sites
+// of the cells sharing it, and reports the offending vertices. A vertex farther than 90° from
+// its sites beyond the tolerance is also offending: the antipode of a circumcenter is
+// equidistant too, but the circumcenter of a CCW triangle is never past the hemisphere.
+func (d *Diagram) checkEquidistance(opts CheckOptions) CheckResult {
+	out := CheckResult{Name: "equidistance"}
+	if d.Dual == BarycentricDual {
+		out.Passed, out.Skipped = true, true
+		out.Detail = "barycentric vertices are not equidistant"
+		return out
+	}
+	cells := make([][]int, len(d.Vertices))
+	for i := range d.NumCells() {
+		for _, v := range (Cell{idx: i, d: d}).VertexIndices() {
+			cells[v] = append(cells[v], i)
+		}
+	}
+	for v, cs := range cells {
+		if len(cs) != 3 {
+			out.Offending = append(out.Offending, v)
+			continue
+		}
+		p := d.Vertices[v]
+		d0, d1, d2 := p.Distance(d.Sites[cs[0]]), p.Distance(d.Sites[cs[1]]),
+			p.Distance(d.Sites[cs[2]])
+		spread := max(d0, d1, d2) - min(d0, d1, d2)
+		out.Residual = max(out.Residual, spread.Radians())
+		if spread > opts.DistanceTolerance || d0 > math.Pi/2+opts.DistanceTolerance {
+			out.Offending = append(out.Offending, v)
+		}
+	}
+	out.Passed = len(out.Offending) == 0
+	return out
+}
+
+// checkLocate locates random points and compares the result with the nearest site found by
+// brute force and with the loop of the located cell, reporting the offending samples. Points
+// within the tolerance of a cell boundary are not checked against the loop.
+func (d *Diagram) checkLocate(opts CheckOptions) CheckResult {
+	out := CheckResult{Name: "locate"}
+	if d.Dual == BarycentricDual {
+		out.Passed, out.Skipped = true, true
+		out.Detail = "barycentric cells are not nearest-site regions"
+		return out
+	}
+	if opts.Samples == 0 {
+		out.Passed, out.Skipped = true, true
+		out.Detail = "no samples"
+		return out
+	}
+	//nolint:gosec
+	random := rand.New(rand.NewSource(opts.Seed))
+	for i := range opts.Samples {
+		p := sampleCap(random, s2.FullCap())
+		got, err := d.walk(p, 0)
+		if err != nil {
+			out.Offending = append(out.Offending, i)
+			out.Detail = err.Error()
+			continue
+		}
+		nearest, second := s1.InfAngle(), s1.InfAngle()
+		for _, s := range d.Sites {
+			dist := p.Distance(s)
+			if dist < nearest {
+				nearest, second = dist, nearest
+			} else if dist < second {
+				second = dist
+			}
+		}
+		excess := p.Distance(d.Sites[got]) - nearest
+		out.Residual = max(out.Residual, excess.Radians())
+		onBoundary := second-nearest <= opts.DistanceTolerance
+		if excess > opts.DistanceTolerance ||
+			(!onBoundary && !(Cell{idx: got, d: d}).Loop().ContainsPoint(p)) {
+			out.Offending = append(out.Offending, i)
+		}
+	}
+	out.Passed = len(out.Offending) == 0
+	return out
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Check
+
+func TestCheck(t *testing.T) {
+	vd := mustNewDiagram(t, 500)
+	r := Check(vd)
+	if !r.OK() {
+		t.Fatalf("Check(vd) failed:\n%s", r)
+	}
+	var names []string
+	for _, c := range r.Results {
+		names = append(names, c.Name)
+		if c.Skipped {
+			t.Errorf("check %q skipped, want run", c.Name)
+		}
+	}
+	want := []string{"structure", "area", "rings", "simple", "convex", "symmetry", "equidistance",
+		"locate"}
+	if !slices.Equal(names, want) {
+		t.Errorf("check names = %v, want %v", names, want)
+	}
+	if s := r.String(); strings.Count(s, "\n") != len(want) || strings.Contains(s, "FAIL") {
+		t.Errorf("r.String() = %q, want one passing line per check", s)
+	}
+
+	bary, err := NewBarycentricDualDiagram(utils.GenerateRandomPoints(100, 0))
+	if err != nil {
+		t.Fatalf("NewBarycentricDualDiagram(...) error = %v, want nil", err)
+	}
+	r = Check(bary)
+	if !r.OK() || !checkResult(r, "equidistance").Skipped {
+		t.Errorf("Check(bary) = %s, want OK with equidistance skipped", r)
+	}
+	if loc := checkResult(r, "locate"); !loc.Skipped || !strings.Contains(loc.Detail, "barycentric") {
+		t.Errorf("Check(bary) locate = %+v, want skipped for the barycentric dual", loc)
+	}
+}
+
+func TestCheck_Corrupted(t *testing.T) {
+	t.Run("vertex", func(t *testing.T) {
+		vd := mustNewDiagram(t, 200)
+		vd.Vertices[5] = s2.Point{Vector: vd.Vertices[5].Add(s2.Ortho(vd.Vertices[5]).Mul(1e-3)).
+			Normalize()}
+		vd.InvalidateCaches()
+		r := Check(vd)
+		eq := checkResult(r, "equidistance")
+		if eq.Passed || !slices.Equal(eq.Offending, []int{5}) {
+			t.Errorf("equidistance = %+v, want failure at vertex 5", eq)
+		}
+		if !strings.Contains(r.String(), "FAIL equidistance") {
+			t.Errorf("r.String() = %q, want an equidistance failure", r)
+		}
+	})
+	t.Run("antipodal vertex", func(t *testing.T) {
+		vd := mustNewDiagram(t, 200)
+		vd.Vertices[5] = s2.Point{Vector: vd.Vertices[5].Mul(-1)}
+		vd.InvalidateCaches()
+		eq := checkResult(Check(vd), "equidistance")
+		if eq.Passed || !slices.Equal(eq.Offending, []int{5}) {
+			t.Errorf("equidistance = %+v, want failure at vertex 5", eq)
+		}
+	})
+	t.Run("ring", func(t *testing.T) {
+		vd := mustNewDiagram(t, 200)
+		start := vd.CellOffsets[3]
+		vd.CellNeighbors[start], vd.CellNeighbors[start+1] = vd.CellNeighbors[start+1],
+			vd.CellNeighbors[start]
+		rings := checkResult(Check(vd), "rings")
+		if rings.Passed || !slices.Contains(rings.Offending, 3) {
+			t.Errorf("rings = %+v, want failure at cell 3", rings)
+		}
+	})
+	t.Run("symmetry", func(t *testing.T) {
+		vd := mustNewDiagram(t, 200)
+		vd.CellNeighbors[vd.CellOffsets[7]] = 7
+		sym := checkResult(Check(vd), "symmetry")
+		if sym.Passed || !slices.Contains(sym.Offending, 7) {
+			t.Errorf("symmetry = %+v, want failure at cell 7", sym)
+		}
+	})
+	t.Run("structure", func(t *testing.T) {
+		vd := mustNewDiagram(t, 200)
+		vd.CellOffsets = vd.CellOffsets[:10]
+		r := Check(vd)
+		if r.OK() || r.Results[0].Passed {
+			t.Fatalf("Check(vd) = %s, want structure failure", r)
+		}
+		for _, c := range r.Results[1:] {
+			if !c.Skipped {
+				t.Errorf("check %q ran on an invalid structure", c.Name)
+			}
+		}
+	})
+}
+
+func TestCheck_SiteChecksum(t *testing.T) {
+	points := utils.GenerateRandomPoints(200, 0)
+	vd, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	if got := checkResult(Check(vd), "sites"); got.Name != "" {
+		t.Errorf("Check(vd) ran the sites check without WithCheckSiteChecksum()")
+	}
+
+	points[0] = s2.PointFromCoords(1, 0, 0)
+	r := Check(vd, WithCheckSiteChecksum())
+	if !r.OK() || !checkResult(r, "sites").Passed {
+		t.Errorf("Check(vd, WithCheckSiteChecksum()) after mutating the input failed:\n%s", r)
+	}
+	if got := vd.CellContainingPoint(vd.Sites[0]).SiteIndex(); got != 0 {
+		t.Errorf("CellContainingPoint(vd.Sites[0]) = %d, want 0", got)
+	}
+
+	vd.Sites[0] = s2.PointFromCoords(1, 0, 0)
+	if got := checkResult(Check(vd, WithCheckSiteChecksum()), "sites"); got.Passed {
+		t.Errorf("sites check passed after mutating vd.Sites")
+	}
+	if err := vd.Rebuild(vd.Sites); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	if got := checkResult(Check(vd, WithCheckSiteChecksum()), "sites"); !got.Passed {
+		t.Errorf("sites check failed after Rebuild")
+	}
+}
+
+func TestCheck_InvalidOption(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	setters := []CheckOption{
+		WithCheckAreaTolerance(0),
+		WithCheckDistanceTolerance(-1),
+		WithCheckConvexTolerance(0),
+		WithCheckSamples(-1, 0),
+	}
+	for _, set := range setters {
+		r := Check(vd, set)
+		if !errors.Is(r.Err, ErrInvalidOption) || r.OK() || len(r.Results) != 0 {
+			t.Errorf("Check(vd, ...) = %+v, want ErrInvalidOption and no results", r)
+		}
+	}
+}
+
+// checkResult returns the result of the named check.
+func checkResult(r Report, name string) CheckResult {
+	for _, c := range r.Results {
+		if c.Name == name {
+			return c
+		}
+	}
+	return CheckResult{}
+}
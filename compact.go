@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// coincidentVertexTolerance is the angle within which CompactVertices merges consecutive ring
+// vertices. It is independent of Eps, which is a relative distance to the hull planes rather
+// than an angle between vertices.
+const coincidentVertexTolerance = s1.Angle(1e-12)
+
+// CompactVertices returns the diagram vertices with coincident vertices merged, and the ring
+// of every cell as indices into them, oriented like VertexIndices. Vertices are coincident
+// when they are consecutive in a ring and within 1e-12 radians of each other, as the
+// circumcenters of cocircular sites are; a merged vertex keeps the position of its lowest
+// original index, and the pool is ordered by that index. Consecutive ring entries that merge
+// are collapsed, so a ring never repeats an index back to back.
+// Every original vertex maps to a single pooled index, so neighboring cells reference identical
+// indices along their shared border and the rings form a crack-free mesh. Unlike VertexIndices,
+// ring entry j is no longer the dual vertex of CellTriangles entry j.
+func (d *Diagram) CompactVertices() (s2.PointVector, [][]int32) {
+	parent := make([]int, len(d.Vertices))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(v int) int {
+		for parent[v] != v {
+			parent[v] = parent[parent[v]]
+			v = parent[v]
+		}
+		return v
+	}
+	for i := range d.NumCells() {
+		ring := Cell{idx: i, d: d}.VertexIndices()
+		for j, a := range ring {
+			b := ring[(j+1)%len(ring)]
+			if d.Vertices[a].Distance(d.Vertices[b]) > coincidentVertexTolerance {
+				continue
+			}
+			// Keep the lowest index as the root so that it names the merged vertex.
+			ra, rb := find(a), find(b)
+			if ra > rb {
+				ra, rb = rb, ra
+			}
+			parent[rb] = ra
+		}
+	}
+
+	pooled := make([]int32, len(d.Vertices))
+	var vertices s2.PointVector
+	for v := range d.Vertices {
+		if r := find(v); r != v {
+			pooled[v] = pooled[r]
+			continue
+		}
+		pooled[v] = int32(len(vertices))
+		vertices = append(vertices, d.Vertices[v])
+	}
+
+	rings := make([][]int32, d.NumCells())
+	for i := range rings {
+		indices := Cell{idx: i, d: d}.VertexIndices()
+		ring := make([]int32, 0, len(indices))
+		for _, v := range indices {
+			if p := pooled[v]; len(ring) == 0 || ring[len(ring)-1] != p {
+				ring = append(ring, p)
+			}
+		}
+		for len(ring) > 1 && ring[len(ring)-1] == ring[0] {
+			ring = ring[:len(ring)-1]
+		}
+		rings[i] = ring
+	}
+	return vertices, rings
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"testing"
+
+	"github.com/golang/geo/s2"
+)
+
+// Compact
+
+func TestDiagram_CompactVertices(t *testing.T) {
+	var cube s2.PointVector
+	for _, x := range []float64{-1, 1} {
+		for _, y := range []float64{-1, 1} {
+			for _, z := range []float64{-1, 1} {
+				cube = append(cube, s2.PointFromCoords(x, y, z))
+			}
+		}
+	}
+	cubeDiagram, err := NewDiagram(cube)
+	if err != nil {
+		t.Fatalf("NewDiagram(cube) error = %v, want nil", err)
+	}
+	cubeSmallEps, err := NewDiagram(cube, WithEps(1e-17))
+	if err != nil {
+		t.Fatalf("NewDiagram(cube, WithEps(1e-17)) error = %v, want nil", err)
+	}
+	random := mustNewDiagram(t, 200)
+
+	tests := []struct {
+		name         string
+		vd           *Diagram
+		wantVertices int
+	}{
+		// The two triangles of each cube face share the circumcenter at the face center.
+		{"cube", cubeDiagram, 6},
+		{"cube with small eps", cubeSmallEps, 6},
+		{"random", random, len(random.Vertices)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vertices, rings := tt.vd.CompactVertices()
+			if len(vertices) != tt.wantVertices {
+				t.Errorf("len(vertices) = %d, want %d", len(vertices), tt.wantVertices)
+			}
+			if len(rings) != tt.vd.NumCells() {
+				t.Fatalf("len(rings) = %d, want %d", len(rings), tt.vd.NumCells())
+			}
+			used := make([]bool, len(vertices))
+			edges := make(map[[2]int32]int)
+			for i, ring := range rings {
+				if len(ring) < 3 {
+					t.Errorf("cell %d: ring %v has fewer than 3 vertices", i, ring)
+				}
+				for j, v := range ring {
+					if v < 0 || int(v) >= len(vertices) {
+						t.Fatalf("cell %d: index %d out of range [0 %d)", i, v, len(vertices))
+					}
+					used[v] = true
+					next := ring[(j+1)%len(ring)]
+					if next == v {
+						t.Errorf("cell %d: ring %v repeats index %d", i, ring, v)
+					}
+					edges[[2]int32{v, next}]++
+				}
+			}
+			for v, ok := range used {
+				if !ok {
+					t.Errorf("vertex %d is not referenced by any ring", v)
+				}
+			}
+			// Crack-free: every directed edge is used once and matched by its reverse.
+			for e, n := range edges {
+				if n != 1 || edges[[2]int32{e[1], e[0]}] != 1 {
+					t.Errorf("edge %v used %d times, reverse %d times, want 1 and 1", e, n,
+						edges[[2]int32{e[1], e[0]}])
+				}
+			}
+		})
+	}
+}
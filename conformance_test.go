@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"bytes"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Conformance
+
+var update = flag.Bool("update", false, "update the conformance golden files")
+
+// conformanceInputs returns the symmetric inputs whose topology is pinned by golden files.
+// Their cocircular sites make the triangulation depend on tie-breaking, so any change to it
+// shows up as a golden diff to be reviewed.
+func conformanceInputs() map[string]s2.PointVector {
+	inputs := map[string]s2.PointVector{
+		"tetrahedron": {
+			s2.PointFromCoords(1, 1, 1), s2.PointFromCoords(1, -1, -1),
+			s2.PointFromCoords(-1, 1, -1), s2.PointFromCoords(-1, -1, 1),
+		},
+		"octahedron": {
+			s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(-1, 0, 0),
+			s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(0, -1, 0),
+			s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1),
+		},
+	}
+	var cube s2.PointVector
+	for _, x := range []float64{-1, 1} {
+		for _, y := range []float64{-1, 1} {
+			for _, z := range []float64{-1, 1} {
+				cube = append(cube, s2.PointFromCoords(x, y, z))
+			}
+		}
+	}
+	inputs["cube"] = cube
+
+	phi := (1 + math.Sqrt(5)) / 2
+	var icosahedron s2.PointVector
+	for _, a := range []float64{-1, 1} {
+		for _, b := range []float64{-phi, phi} {
+			icosahedron = append(icosahedron, s2.PointFromCoords(0, a, b),
+				s2.PointFromCoords(a, b, 0), s2.PointFromCoords(b, 0, a))
+		}
+	}
+	inputs["icosahedron"] = icosahedron
+
+	graticule := s2.PointVector{s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1)}
+	for lat := -80; lat <= 80; lat += 10 {
+		for lng := 0; lng < 360; lng += 10 {
+			graticule = append(graticule,
+				s2.PointFromLatLng(s2.LatLngFromDegrees(float64(lat), float64(lng))))
+		}
+	}
+	inputs["graticule"] = graticule
+	return inputs
+}
+
+func TestConformance(t *testing.T) {
+	for name, sites := range conformanceInputs() {
+		t.Run(name, func(t *testing.T) {
+			dt, err := s2delaunay.NewTriangulation(sites)
+			if err != nil {
+				t.Fatalf("s2delaunay.NewTriangulation(%s) error = %v, want nil", name, err)
+			}
+			vd, err := NewDiagramFromTriangulation(dt)
+			if err != nil {
+				t.Fatalf("NewDiagramFromTriangulation(%s) error = %v, want nil", name, err)
+			}
+
+			var tri, diagram bytes.Buffer
+			if err := dt.DumpInternals(&tri); err != nil {
+				t.Fatalf("dt.DumpInternals(...) error = %v, want nil", err)
+			}
+			if err := vd.DumpInternals(&diagram); err != nil {
+				t.Fatalf("vd.DumpInternals(...) error = %v, want nil", err)
+			}
+			dir := filepath.Join("testdata", "conformance")
+			assertGolden(t, tri.String(), filepath.Join(dir, name+".triangulation.golden"))
+			assertGolden(t, diagram.String(), filepath.Join(dir, name+".diagram.golden"))
+		})
+	}
+}
+
+// assertGolden compares got with the golden file at path, or writes it there with -update.
+func assertGolden(t *testing.T, got, path string) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("os.MkdirAll(%q) error = %v, want nil", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("os.WriteFile(%q) error = %v, want nil", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%q) error = %v, want nil; run go test -update", path, err)
+	}
+	if diff := cmp.Diff(string(want), got); diff != "" {
+		t.Errorf("%s mismatch (-want +got):\n%s", path, diff)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Reflex describes a ring vertex at which a cell turns away from its site.
+type Reflex struct {
+	// Cell is the index of the cell.
+	Cell int
+	// Vertex is the index of the vertex in Vertices.
+	Vertex int
+	// Turn is the turn angle at the vertex, positive when the ring turns toward the site as a
+	// convex ring does, so a reflex vertex has a negative turn.
+	Turn s1.Angle
+}
+
+// String renders the reflex vertex for diagnostics.
+func (r Reflex) String() string {
+	return fmt.Sprintf("cell %d vertex %d turn %v", r.Cell, r.Vertex, r.Turn.Radians())
+}
+
+// IsConvex reports whether the ring of the cell turns toward the site at every vertex, allowing
+// turns the other way of at most tol. Voronoi cells are geodesically convex, so a failure
+// points at rounding or corrupted vertices.
+func (c Cell) IsConvex(tol s1.Angle) bool {
+	_, ok := c.FirstReflex(tol)
+	return !ok
+}
+
+// FirstReflex returns the first vertex in ring order at which the cell turns away from the site
+// by more than tol, and false if there is none. Vertices coincident with a ring neighbor have
+// no defined turn and are skipped.
+func (c Cell) FirstReflex(tol s1.Angle) (Reflex, bool) {
+	indices := c.VertexIndices()
+	n := len(indices)
+	for k, v := range indices {
+		prev, cur, next := c.d.Vertices[indices[(k+n-1)%n]], c.d.Vertices[v],
+			c.d.Vertices[indices[(k+1)%n]]
+		if prev == cur || cur == next {
+			continue
+		}
+		// Rings are CCW when looking out of the sphere, which is CW in the s2 convention, so a
+		// convex ring turns right and s2.TurnAngle is negative.
+		turn := -s2.TurnAngle(prev, cur, next)
+		if turn < -tol {
+			return Reflex{Cell: c.idx, Vertex: v, Turn: turn}, true
+		}
+	}
+	return Reflex{}, false
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Convexity
+
+func TestCell_IsConvex(t *testing.T) {
+	vd := mustNewDiagram(t, 500)
+	for i := range vd.NumCells() {
+		c := Cell{idx: i, d: vd}
+		if r, ok := c.FirstReflex(1e-12); ok {
+			t.Errorf("cell %d: FirstReflex(1e-12) = %v, want none", i, r)
+		}
+		if !c.IsConvex(1e-12) {
+			t.Errorf("cell %d: IsConvex(1e-12) = false, want true", i)
+		}
+	}
+	if got := checkResult(Check(vd), "convex"); !got.Passed || got.Skipped {
+		t.Errorf("convex check = %+v, want passed", got)
+	}
+}
+
+func TestCell_IsConvex_Perturbed(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	c := Cell{idx: 3, d: vd}
+	v := c.VertexIndices()[0]
+	// Pulling a vertex most of the way to the site makes the ring turn away from the site there.
+	vd.Vertices[v] = s2.Interpolate(0.9, vd.Vertices[v], c.Site())
+
+	r, ok := c.FirstReflex(1e-9)
+	if !ok || r.Cell != 3 || r.Vertex != v || r.Turn >= 0 {
+		t.Fatalf("FirstReflex(1e-9) = %v, %v, want a negative turn at vertex %d", r, ok, v)
+	}
+	if c.IsConvex(1e-9) {
+		t.Errorf("IsConvex(1e-9) = true, want false")
+	}
+	if !c.IsConvex(-r.Turn + s1.Angle(1e-9)) {
+		t.Errorf("IsConvex(%v) = false, want true within the reflex turn", -r.Turn)
+	}
+
+	got := checkResult(Check(vd, WithCheckSamples(0, 0)), "convex")
+	if got.Passed || !slices.Contains(got.Offending, 3) || !strings.Contains(got.Detail,
+		"vertex") {
+		t.Errorf("convex check = %+v, want failure at cell 3 with the reflex vertex", got)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"github.com/golang/geo/s2"
+)
+
+// InteriorExteriorCovering covers the cell with two disjoint sets of S2 cells: interior cells
+// lie entirely inside the Voronoi cell, and boundary cells straddle its boundary, so together
+// they cover the cell and every point of an interior cell is answered without an exact test.
+// Starting from a bound of the cell, boundary cells are split, largest first, and their
+// children classified as long as the total number of cells stays within maxCells. The result
+// holds at least the cells of the initial bound, up to 4, even if maxCells is smaller.
+func (c Cell) InteriorExteriorCovering(maxCells int) (interior, boundary s2.CellUnion) {
+	loop := c.Loop()
+	// The queue holds the boundary candidates in order of decreasing size.
+	queue := loop.CellUnionBound()
+	for len(queue) > 0 {
+		id := queue[0]
+		if len(interior)+len(queue)+3 > maxCells || id.IsLeaf() {
+			break
+		}
+		queue = queue[1:]
+		for _, child := range id.Children() {
+			cell := s2.CellFromCellID(child)
+			switch {
+			case loop.ContainsCell(cell):
+				interior = append(interior, child)
+			case loop.IntersectsCell(cell):
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	// Cells that were never split are classified as they are.
+	for _, id := range queue {
+		cell := s2.CellFromCellID(id)
+		switch {
+		case loop.ContainsCell(cell):
+			interior = append(interior, id)
+		case loop.IntersectsCell(cell):
+			boundary = append(boundary, id)
+		}
+	}
+	interior.Normalize()
+	boundary.Normalize()
+	return interior, boundary
+}
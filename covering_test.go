@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"testing"
+
+	"github.com/golang/geo/s2"
+)
+
+// Covering
+
+func TestCell_InteriorExteriorCovering(t *testing.T) {
+	vd := mustNewDiagram(t, 50)
+	const maxCells = 64
+	for i := range vd.NumCells() {
+		c, _ := vd.Cell(i)
+		interior, boundary := c.InteriorExteriorCovering(maxCells)
+		if n := len(interior) + len(boundary); n > maxCells {
+			t.Errorf("cell %d: %d covering cells, want <= %d", i, n, maxCells)
+		}
+		if len(interior) == 0 {
+			t.Errorf("cell %d: empty interior covering", i)
+		}
+		if interior.Intersects(boundary) {
+			t.Errorf("cell %d: interior and boundary coverings overlap", i)
+		}
+
+		for _, id := range interior {
+			cell := s2.CellFromCellID(id)
+			for k := range 4 {
+				p := s2.Point{Vector: cell.Vertex(k).Add(cell.Center().Vector).Normalize()}
+				if got := vd.CellContainingPoint(p).SiteIndex(); got != i {
+					t.Errorf("cell %d: interior point %v located in cell %d", i, p, got)
+				}
+			}
+		}
+
+		// The boundary cells cover every point of the ring.
+		for k := range c.NumVertices() {
+			a, _ := c.Vertex(k)
+			b, _ := c.Vertex((k + 1) % c.NumVertices())
+			for s := range 10 {
+				p := s2.Interpolate(float64(s)/10, a, b)
+				if !boundary.ContainsPoint(p) {
+					t.Errorf("cell %d: boundary covering misses edge point %v", i, p)
+				}
+			}
+		}
+	}
+}
+
+func TestCell_InteriorExteriorCovering_SmallBudget(t *testing.T) {
+	vd := mustNewDiagram(t, 50)
+	c, _ := vd.Cell(0)
+	interior, boundary := c.InteriorExteriorCovering(0)
+	if n := len(interior) + len(boundary); n == 0 || n > 4 {
+		t.Errorf("covering has %d cells, want the initial bound of 1 to 4", n)
+	}
+}
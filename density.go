@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+// DensityOptions holds configuration options for Voronoi density estimation.
+type DensityOptions struct {
+	// Rings is the number of neighbor rings averaged into each estimate. Zero disables smoothing.
+	Rings int
+	// Radius is the sphere radius used to express densities per unit of area. The default of 1
+	// yields counts per steradian.
+	Radius float64
+	// DiagramOptions are passed through to NewDiagram.
+	DiagramOptions []DiagramOption
+}
+
+// DensityOption is a functional option type for density estimation configuration.
+type DensityOption func(*DensityOptions) error
+
+// WithSmoothingRings averages each estimate over the cells within k neighbor rings.
+// It must not be negative.
+func WithSmoothingRings(k int) DensityOption {
+	return func(o *DensityOptions) error {
+		if k < 0 {
+			return &OptionError{Name: "WithSmoothingRings",
+				Err: fmt.Errorf("%w: k must not be negative got %d", ErrInvalidOption, k)}
+		}
+		o.Rings = k
+		return nil
+	}
+}
+
+// WithSphereRadius expresses densities per unit of area on a sphere of radius r, for example
+// per km² with r = 6371. It must be positive.
+func WithSphereRadius(r float64) DensityOption {
+	return func(o *DensityOptions) error {
+		if r <= 0 {
+			return &OptionError{Name: "WithSphereRadius",
+				Err: fmt.Errorf("%w: r must be positive got %v", ErrInvalidOption, r)}
+		}
+		o.Radius = r
+		return nil
+	}
+}
+
+// WithDensityDiagramOptions sets the options used to construct the underlying diagram.
+func WithDensityDiagramOptions(opts ...DiagramOption) DensityOption {
+	return func(o *DensityOptions) error {
+		o.DiagramOptions = opts
+		return nil
+	}
+}
+
+// DensityEstimate computes the Voronoi density estimate of the points: the density at each
+// point is the inverse area of its cell, optionally averaged over neighboring cells.
+// It returns the per-point densities along with the diagram they were derived from.
+// It returns an error if an option is invalid or the diagram cannot be constructed, or
+// wrapping ErrNoNeighbors if smoothing rings are requested for a diagram built
+// WithoutNeighbors.
+func DensityEstimate(points s2.PointVector, setters ...DensityOption) ([]float64, *Diagram,
+	error) {
+	opts := DensityOptions{
+		Radius: 1,
+	}
+	for _, set := range setters {
+		err := set(&opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	d, err := NewDiagram(points, opts.DiagramOptions...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DensityEstimate: %w", err)
+	}
+
+	numCells := d.NumCells()
+	raw := make([]float64, numCells)
+	scale := opts.Radius * opts.Radius
+	for i, a := range d.CellAreas() {
+		raw[i] = 1 / (a * scale)
+	}
+	if opts.Rings == 0 {
+		return raw, d, nil
+	}
+	if !d.hasNeighbors() {
+		return nil, nil, fmt.Errorf("DensityEstimate: %w", ErrNoNeighbors)
+	}
+
+	density := make([]float64, numCells)
+	for i := range numCells {
+		neighborhood := d.ringNeighborhood(i, opts.Rings)
+		sum := 0.0
+		for _, j := range neighborhood {
+			sum += raw[j]
+		}
+		density[i] = sum / float64(len(neighborhood))
+	}
+	return density, d, nil
+}
+
+// ringNeighborhood returns the cell itself followed by all cells within k neighbor rings of it,
+// in breadth-first order.
+func (d *Diagram) ringNeighborhood(i, k int) []int {
+	cells := []int{i}
+	seen := map[int]bool{i: true}
+	begin := 0
+	for range k {
+		end := len(cells)
+		for _, c := range cells[begin:end] {
+			for _, n := range (Cell{idx: c, d: d}).NeighborIndices() {
+				if !seen[n] {
+					seen[n] = true
+					cells = append(cells, n)
+				}
+			}
+		}
+		begin = end
+	}
+	return cells
+}
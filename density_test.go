@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// DensityOptions
+
+func TestWithSmoothingRings(t *testing.T) {
+	tests := []struct {
+		name    string
+		k       int
+		wantErr bool
+	}{
+		{"k positive", 2, false},
+		{"k zero", 0, false},
+		{"k negative", -1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &DensityOptions{}
+			err := WithSmoothingRings(tt.k)(opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WithSmoothingRings(%v) error = %v, wantErr %v", tt.k, err, tt.wantErr)
+			}
+			if err == nil && opts.Rings != tt.k {
+				t.Errorf("WithSmoothingRings(%v) opts.Rings = %v, want %v", tt.k, opts.Rings, tt.k)
+			}
+		})
+	}
+}
+
+func TestWithSphereRadius(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       float64
+		wantErr bool
+	}{
+		{"r positive", 6371, false},
+		{"r zero", 0, true},
+		{"r negative", -1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &DensityOptions{Radius: 1}
+			err := WithSphereRadius(tt.r)(opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WithSphereRadius(%v) error = %v, wantErr %v", tt.r, err, tt.wantErr)
+			}
+			if err == nil && opts.Radius != tt.r {
+				t.Errorf("WithSphereRadius(%v) opts.Radius = %v, want %v", tt.r, opts.Radius, tt.r)
+			}
+		})
+	}
+}
+
+// Density
+
+func TestDensityEstimate(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	density, vd, err := DensityEstimate(points)
+	if err != nil {
+		t.Fatalf("DensityEstimate(...) error = %v, want nil", err)
+	}
+	if len(density) != vd.NumCells() {
+		t.Fatalf("DensityEstimate(...) len = %d, want %d", len(density), vd.NumCells())
+	}
+	total := 0.0
+	for i, rho := range density {
+		total += 1 / rho
+		c, err := vd.Cell(i)
+		if err != nil {
+			t.Fatalf("vd.Cell(%d) error = %v, want nil", i, err)
+		}
+		if want := 1 / c.Loop().Area(); math.Abs(rho-want) > 1e-9*want {
+			t.Errorf("density[%d] = %v, want %v", i, rho, want)
+		}
+	}
+	if math.Abs(total-4*math.Pi) > 1e-9 {
+		t.Errorf("sum of inverse densities = %v, want 4π", total)
+	}
+
+	const r = 6371.0
+	scaled, _, err := DensityEstimate(points, WithSphereRadius(r))
+	if err != nil {
+		t.Fatalf("DensityEstimate(..., WithSphereRadius(%v)) error = %v, want nil", r, err)
+	}
+	for i := range density {
+		if want := density[i] / (r * r); math.Abs(scaled[i]-want) > 1e-9*want {
+			t.Errorf("scaled[%d] = %v, want %v", i, scaled[i], want)
+		}
+	}
+
+	if _, _, err := DensityEstimate(points[:3]); err == nil {
+		t.Errorf("DensityEstimate(3 points) error = nil, want non-nil")
+	}
+}
+
+func TestDensityEstimate_TwoClusters(t *testing.T) {
+	//nolint:gosec
+	random := rand.New(rand.NewSource(0))
+	clusters := []s2.Cap{
+		s2.CapFromCenterAngle(s2.PointFromCoords(1, 0, 0), s1.Angle(0.2)),
+		s2.CapFromCenterAngle(s2.PointFromCoords(0, 0, -1), s1.Angle(0.2)),
+	}
+	points := utils.GenerateRandomPoints(200, 0)
+	for _, c := range clusters {
+		for range 200 {
+			points = append(points, sampleCap(random, c))
+		}
+	}
+
+	density, _, err := DensityEstimate(points, WithSmoothingRings(1))
+	if err != nil {
+		t.Fatalf("DensityEstimate(...) error = %v, want nil", err)
+	}
+	var inside, outside []float64
+	for i, p := range points {
+		in := false
+		for _, c := range clusters {
+			in = in || c.ContainsPoint(p)
+		}
+		switch {
+		case in && i >= 200:
+			inside = append(inside, density[i])
+		case !in:
+			outside = append(outside, density[i])
+		}
+	}
+	if median(inside) < 10*median(outside) {
+		t.Errorf("cluster median density = %v, want > 10 × background %v", median(inside),
+			median(outside))
+	}
+}
+
+func TestDiagram_RingNeighborhood(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	c, err := vd.Cell(0)
+	if err != nil {
+		t.Fatalf("vd.Cell(0) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff([]int{0}, vd.ringNeighborhood(0, 0)); diff != "" {
+		t.Errorf("vd.ringNeighborhood(0, 0) mismatch (-want +got):\n%s", diff)
+	}
+	want := append([]int{0}, c.NeighborIndices()...)
+	if diff := cmp.Diff(want, vd.ringNeighborhood(0, 1)); diff != "" {
+		t.Errorf("vd.ringNeighborhood(0, 1) mismatch (-want +got):\n%s", diff)
+	}
+	if got := len(vd.ringNeighborhood(0, 100)); got != vd.NumCells() {
+		t.Errorf("len(vd.ringNeighborhood(0, 100)) = %d, want %d", got, vd.NumCells())
+	}
+}
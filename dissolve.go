@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// Dissolve merges adjacent cells sharing a label into one polygon per label.
+// Cells share their boundary vertices exactly, so the union is built by dropping the edges
+// between cells of the same label and chaining the remaining edges into loops, with holes
+// where a region encloses cells of other labels.
+// It returns an error if a merged polygon is invalid, which can happen when distinct Voronoi
+// vertices coincide, or wrapping ErrNoNeighbors if the diagram was built WithoutNeighbors.
+func (d *Diagram) Dissolve(label func(cell int) string) (map[string]*s2.Polygon, error) {
+	if !d.hasNeighbors() {
+		return nil, fmt.Errorf("Dissolve: %w", ErrNoNeighbors)
+	}
+	labels := make([]string, d.NumCells())
+	counts := make(map[string]int)
+	for i := range labels {
+		labels[i] = label(i)
+		counts[labels[i]]++
+	}
+
+	// next maps the start vertex of a boundary edge to its end vertex, per label. In a valid
+	// diagram every Voronoi vertex starts at most one boundary edge of a given label.
+	next := make(map[string]map[int]int, len(counts))
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}
+		vertices, neighbors := c.VertexIndices(), c.NeighborIndices()
+		n := len(vertices)
+		for k, nb := range neighbors {
+			// The edge from vertex k to k+1 separates the cell from neighbor k; it is walked
+			// backwards to keep the cell on the left.
+			if labels[nb] == labels[i] {
+				continue
+			}
+			m := next[labels[i]]
+			if m == nil {
+				m = make(map[int]int)
+				next[labels[i]] = m
+			}
+			m[vertices[(k+1)%n]] = vertices[k]
+		}
+	}
+
+	polygons := make(map[string]*s2.Polygon, len(counts))
+	for l := range counts {
+		m := next[l]
+		if len(m) == 0 {
+			polygons[l] = s2.FullPolygon()
+			continue
+		}
+		starts := make([]int, 0, len(m))
+		for v := range m {
+			starts = append(starts, v)
+		}
+		slices.Sort(starts)
+
+		var loops []*s2.Loop
+		for _, s := range starts {
+			if _, ok := m[s]; !ok {
+				continue
+			}
+			var points []s2.Point
+			for v, ok := s, true; ok; {
+				p := d.Vertices[v]
+				if len(points) == 0 || points[len(points)-1] != p {
+					points = append(points, p)
+				}
+				nv := m[v]
+				delete(m, v)
+				v = nv
+				_, ok = m[v]
+			}
+			if len(points) > 1 && points[0] == points[len(points)-1] {
+				points = points[:len(points)-1]
+			}
+			loops = append(loops, s2.LoopFromPoints(points))
+		}
+
+		p := s2.PolygonFromOrientedLoops(loops)
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("Dissolve: label %q: %w", l, err)
+		}
+		polygons[l] = p
+	}
+	return polygons, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"testing"
+)
+
+// Dissolve
+
+func TestDiagram_Dissolve(t *testing.T) {
+	vd := mustNewDiagram(t, 500)
+	tests := []struct {
+		name      string
+		label     func(i int) string
+		wantLoops map[string]int
+	}{
+		{
+			name:      "single",
+			label:     func(int) string { return "all" },
+			wantLoops: map[string]int{"all": 1},
+		},
+		{
+			name: "hemispheres",
+			label: func(i int) string {
+				if vd.Sites[i].Z > 0 {
+					return "north"
+				}
+				return "south"
+			},
+			wantLoops: map[string]int{"north": 1, "south": 1},
+		},
+		{
+			name: "band",
+			label: func(i int) string {
+				if math.Abs(vd.Sites[i].Z) < 0.3 {
+					return "band"
+				}
+				return "caps"
+			},
+			wantLoops: map[string]int{"band": 2, "caps": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vd.Dissolve(tt.label)
+			if err != nil {
+				t.Fatalf("vd.Dissolve(...) error = %v, want nil", err)
+			}
+			if len(got) != len(tt.wantLoops) {
+				t.Fatalf("len(vd.Dissolve(...)) = %d, want %d", len(got), len(tt.wantLoops))
+			}
+
+			const eps = 1e-9
+			total := 0.0
+			for l, p := range got {
+				if p.NumLoops() != tt.wantLoops[l] {
+					t.Errorf("polygons[%q].NumLoops() = %d, want %d", l, p.NumLoops(),
+						tt.wantLoops[l])
+				}
+				total += p.Area()
+			}
+			if math.Abs(total-4*math.Pi) > eps {
+				t.Errorf("total area = %v, want 4π", total)
+			}
+
+			cellArea := make(map[string]float64)
+			for i := range vd.NumCells() {
+				l := tt.label(i)
+				cellArea[l] += Cell{idx: i, d: vd}.Loop().Area()
+				// ContainsPoint is not supported on full polygons.
+				if !got[l].IsFull() && !got[l].ContainsPoint(vd.Sites[i]) {
+					t.Errorf("polygons[%q].ContainsPoint(vd.Sites[%d]) = false, want true", l, i)
+				}
+			}
+			for l, want := range cellArea {
+				if g := got[l].Area(); math.Abs(g-want) > eps {
+					t.Errorf("polygons[%q].Area() = %v, want %v", l, g, want)
+				}
+			}
+		})
+	}
+}
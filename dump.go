@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// DumpInternals writes a canonical, human-readable description of the diagram topology to w,
+// suitable for diffing against a golden file. It lists the cell and vertex counts, the cell
+// offsets, every Voronoi vertex as the sorted indices of the cells meeting at it, in
+// lexicographic order, and the CCW ring of neighbors of every cell rotated to start at the
+// smallest. The dump does not depend on the order of Vertices, so it is stable across
+// triangulations that enumerate the same triangles differently. Coordinates are not included.
+// It returns an error wrapping ErrNoNeighbors if the diagram was built WithoutNeighbors.
+func (d *Diagram) DumpInternals(w io.Writer) error {
+	if !d.hasNeighbors() {
+		return fmt.Errorf("DumpInternals: %w", ErrNoNeighbors)
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "cells %d\n", d.NumCells())
+	fmt.Fprintf(bw, "vertices %d\n", len(d.Vertices))
+	fmt.Fprintf(bw, "offsets%s\n", formatInts(d.CellOffsets))
+
+	cells := make([][]int, len(d.Vertices))
+	for i := range d.NumCells() {
+		for _, v := range (Cell{idx: i, d: d}).VertexIndices() {
+			cells[v] = append(cells[v], i)
+		}
+	}
+	slices.SortFunc(cells, slices.Compare)
+	for _, c := range cells {
+		fmt.Fprintf(bw, "vertex%s\n", formatInts(c))
+	}
+	for i := range d.NumCells() {
+		ring := canonicalRing(Cell{idx: i, d: d}.NeighborIndices())
+		fmt.Fprintf(bw, "cell %d neighbors%s\n", i, formatInts(ring))
+	}
+	return bw.Flush()
+}
+
+// formatInts formats the values, each preceded by a space.
+func formatInts(values []int) string {
+	var b []byte
+	for _, v := range values {
+		b = fmt.Appendf(b, " %d", v)
+	}
+	return string(b)
+}
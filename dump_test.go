@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Dump
+
+func TestDiagram_DumpInternals(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	var want bytes.Buffer
+	if err := vd.DumpInternals(&want); err != nil {
+		t.Fatalf("vd.DumpInternals(...) error = %v, want nil", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(want.String(), "\n"), "\n")
+	if got, n := len(lines), 3+len(vd.Vertices)+vd.NumCells(); got != n {
+		t.Fatalf("vd.DumpInternals(...) wrote %d lines, want %d", got, n)
+	}
+	if got := lines[0]; got != fmt.Sprintf("cells %d", vd.NumCells()) {
+		t.Errorf("lines[0] = %q, want cells %d", got, vd.NumCells())
+	}
+	for _, l := range lines[3 : 3+len(vd.Vertices)] {
+		if f := strings.Fields(l); len(f) != 4 || f[0] != "vertex" {
+			t.Errorf("vertex line %q, want vertex and three cells", l)
+		}
+	}
+
+	// The dual type moves the vertices but leaves the topology unchanged.
+	other, err := NewBarycentricDualDiagram(utils.GenerateRandomPoints(100, 0))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	var got bytes.Buffer
+	if err := other.DumpInternals(&got); err != nil {
+		t.Fatalf("other.DumpInternals(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want.String(), got.String()); diff != "" {
+		t.Errorf("other.DumpInternals(...) mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/2dChan/s2voronoi/internal/wire"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	diagramMagic   = 0x44563253 // "S2VD"
+	diagramVersion = 2
+
+	// unitNormTolerance bounds the deviation from unit norm accepted for decoded, moved and
+	// overridden points.
+	unitNormTolerance = 1e-9
+)
+
+// MarshalBinary encodes the diagram and its numeric options in a compact little-endian binary
+// format. A VertexOverride callback cannot be encoded and is dropped, and the neighbors of a
+// diagram built WithoutNeighbors are encoded as absent.
+// It implements encoding.BinaryMarshaler.
+func (d *Diagram) MarshalBinary() ([]byte, error) {
+	var w wire.Writer
+	w.Uint32(diagramMagic)
+	w.Uint32(diagramVersion)
+	w.Uint32(uint32(d.Dual))
+	w.Float64(d.opts.Eps)
+	autoEps := uint32(0)
+	if d.opts.AutoEps {
+		autoEps = 1
+	}
+	w.Uint32(autoEps)
+	w.Float64(d.opts.OverrideTolerance.Radians())
+	w.Uint32(uint32(d.opts.IDLevel))
+	w.Float64(d.opts.MaxRadius.Radians())
+	w.Points(d.Sites)
+	w.Points(d.Vertices)
+	for _, s := range [][]int{d.CellVertices, d.CellNeighbors, d.CellOffsets} {
+		if err := w.Ints(s); err != nil {
+			return nil, fmt.Errorf("MarshalBinary: %w", err)
+		}
+	}
+	return w.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a diagram produced by MarshalBinary, replacing the contents of d.
+// The decoded arrays are checked for structural consistency.
+// It implements encoding.BinaryUnmarshaler and returns an error wrapping ErrInvalidEncoding if
+// the data is malformed.
+func (d *Diagram) UnmarshalBinary(data []byte) error {
+	r := wire.NewReader(data)
+	magic, version := r.Uint32(), r.Uint32()
+	if magic != diagramMagic || version < 1 || version > diagramVersion {
+		return fmt.Errorf("UnmarshalBinary: %w: bad magic %#x or version %d", ErrInvalidEncoding,
+			magic, version)
+	}
+	dual := DualType(r.Uint32())
+	opts := DiagramOptions{Eps: r.Float64()}
+	opts.AutoEps = r.Uint32() != 0
+	opts.OverrideTolerance = s1.Angle(r.Float64())
+	opts.IDLevel = int(r.Uint32())
+	if version >= 2 {
+		opts.MaxRadius = s1.Angle(r.Float64())
+	}
+	sites := r.Points()
+	vertices := r.Points()
+	cellVertices := r.Ints()
+	cellNeighbors := r.Ints()
+	cellOffsets := r.Ints()
+	if err := r.Close(); err != nil {
+		return fmt.Errorf("UnmarshalBinary: %w: %w", ErrInvalidEncoding, err)
+	}
+	if dual != CircumcentricDual && dual != BarycentricDual {
+		return fmt.Errorf("UnmarshalBinary: %w: unknown dual type %d", ErrInvalidEncoding, dual)
+	}
+	if opts.IDLevel > s2.MaxLevel {
+		return fmt.Errorf("UnmarshalBinary: %w: id level %d", ErrInvalidEncoding, opts.IDLevel)
+	}
+	if !(opts.MaxRadius >= 0 && opts.MaxRadius <= math.Pi) {
+		return fmt.Errorf("UnmarshalBinary: %w: max radius %v", ErrInvalidEncoding,
+			opts.MaxRadius)
+	}
+
+	d.InvalidateCaches()
+	d.Sites = sites
+	d.recordSites()
+	d.Vertices = vertices
+	d.CellVertices = cellVertices
+	d.CellNeighbors = cellNeighbors
+	if len(cellNeighbors) == 0 {
+		d.CellNeighbors = nil
+	}
+	d.CellOffsets = cellOffsets
+	d.Dual = dual
+	d.opts = opts
+	if err := d.checkStructure(); err != nil {
+		return fmt.Errorf("UnmarshalBinary: %w: %w", ErrInvalidEncoding, err)
+	}
+	return nil
+}
+
+// checkStructure verifies that the arrays of the diagram are mutually consistent: array sizes,
+// index bounds, CSR offsets and unit-norm points. CellNeighbors may be absent.
+func (d *Diagram) checkStructure() error {
+	numCells := len(d.Sites)
+	if numCells < 4 {
+		return ErrInsufficientSites
+	}
+	if len(d.Vertices) != 2*(numCells-2) || len(d.CellOffsets) != numCells+1 ||
+		(d.hasNeighbors() && len(d.CellNeighbors) != len(d.CellVertices)) {
+		return fmt.Errorf("array sizes %d, %d, %d do not match %d sites", len(d.Vertices),
+			len(d.CellOffsets), len(d.CellNeighbors), numCells)
+	}
+	for _, points := range []s2.PointVector{d.Sites, d.Vertices} {
+		for i, p := range points {
+			if n := p.Norm(); math.IsNaN(n) || math.Abs(n-1) > unitNormTolerance {
+				return fmt.Errorf("point %d norm %v is not unit", i, n)
+			}
+		}
+	}
+	if d.CellOffsets[0] != 0 || d.CellOffsets[numCells] != len(d.CellVertices) {
+		return fmt.Errorf("cell offsets do not span %d indices", len(d.CellVertices))
+	}
+	for i := range numCells {
+		if d.CellOffsets[i] > d.CellOffsets[i+1] {
+			return fmt.Errorf("cell offsets decrease at cell %d", i)
+		}
+	}
+	for _, v := range d.CellVertices {
+		if v < 0 || v >= len(d.Vertices) {
+			return fmt.Errorf("cell vertex %d %w [0 %d)", v, ErrOutOfRange, len(d.Vertices))
+		}
+	}
+	if !d.hasNeighbors() {
+		return nil
+	}
+	for _, n := range d.CellNeighbors {
+		if n < 0 || n >= numCells {
+			return fmt.Errorf("cell neighbor %d %w [0 %d)", n, ErrOutOfRange, numCells)
+		}
+	}
+	return nil
+}
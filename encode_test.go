@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// Encoding
+
+func TestDiagram_MarshalBinary(t *testing.T) {
+	want := mustNewDiagram(t, 100)
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("want.MarshalBinary() error = %v, want nil", err)
+	}
+	got := new(Diagram)
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("got.UnmarshalBinary(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(Diagram{})); diff != "" {
+		t.Errorf("UnmarshalBinary(MarshalBinary()) mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.opts, got.opts, cmpopts.IgnoreFields(DiagramOptions{},
+		"VertexOverride")); diff != "" {
+		t.Errorf("decoded options mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiagram_UnmarshalBinary_Invalid(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	vd.CellNeighbors[0] = vd.NumCells()
+	inconsistent, err := vd.MarshalBinary()
+	if err != nil {
+		t.Fatalf("vd.MarshalBinary() error = %v, want nil", err)
+	}
+	valid, err := mustNewDiagram(t, 10).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated", valid[:len(valid)-1]},
+		{"inconsistent", inconsistent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := new(Diagram).UnmarshalBinary(tt.data)
+			if !errors.Is(err, ErrInvalidEncoding) {
+				t.Errorf("UnmarshalBinary(...) error = %v, want ErrInvalidEncoding", err)
+			}
+		})
+	}
+}
+
+func TestDiagram_UnmarshalText_Invalid(t *testing.T) {
+	valid, err := mustNewDiagram(t, 10).MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v, want nil", err)
+	}
+	lines := strings.SplitAfter(string(valid), "\n")
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"truncated", strings.Join(lines[:len(lines)-2], "")},
+		{"trailing", string(valid) + "n 1\n"},
+		{"bad float", strings.Replace(string(valid), "eps 0x", "eps 0y", 1)},
+		{"bad dual", strings.Replace(string(valid), "dual 0", "dual 7", 1)},
+		{"bad neighbor", strings.Replace(string(valid), "\nn 1", "\nn 10", 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := new(Diagram).UnmarshalText([]byte(tt.data))
+			if !errors.Is(err, ErrInvalidEncoding) {
+				t.Errorf("UnmarshalText(...) error = %v, want ErrInvalidEncoding", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+)
+
+// Sentinel errors wrapped by the errors returned from this package. Test for them with
+// errors.Is. Construction errors also wrap the underlying s2delaunay error.
+var (
+	// ErrDiagramConstruction reports that a diagram could not be built from its sites.
+	ErrDiagramConstruction = errors.New("diagram construction failed")
+	// ErrInsufficientSites reports that fewer than 4 sites were given.
+	ErrInsufficientSites = errors.New("need at least 4 sites")
+	// ErrDegenerateSites reports sites whose convex hull is degenerate, such as coplanar sites.
+	ErrDegenerateSites = errors.New("sites are coplanar or degenerate")
+	// ErrInvalidOption reports an option with an invalid value.
+	ErrInvalidOption = errors.New("invalid option")
+	// ErrOutOfRange reports an index outside the valid range.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrNotFound reports a lookup for an ID that does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrIDCollision reports two cells sharing a stable ID.
+	ErrIDCollision = errors.New("id collision")
+	// ErrInvalidEncoding reports serialized data that is malformed or inconsistent.
+	ErrInvalidEncoding = errors.New("invalid encoding")
+	// ErrNoNeighbors reports a neighbor query on a diagram built WithoutNeighbors.
+	ErrNoNeighbors = errors.New("neighbors not built")
+	// ErrInvalidRings reports externally computed rings that do not form a Voronoi diagram.
+	ErrInvalidRings = errors.New("invalid rings")
+	// ErrCorruptNeighbors reports neighbor rings that the point location walk cannot follow.
+	ErrCorruptNeighbors = errors.New("corrupt neighbor rings")
+)
+
+// OptionError is returned by every option of this package that rejects its value, and by
+// constructors for options that conflict with each other. It is the same type as
+// s2delaunay.OptionError, with Err wrapping this package's sentinels.
+type OptionError = s2delaunay.OptionError
+
+// maxDuplicateSites is the number of duplicate pairs a construction error lists in its message.
+const maxDuplicateSites = 8
+
+// constructionError reports a diagram construction failure in terms of sites rather than
+// triangulation vertices. It matches ErrDiagramConstruction, the voronoi-level sentinel
+// describing the failure if any, and the underlying cause.
+type constructionError struct {
+	op    string
+	kind  error
+	cause error
+	// detail describes the cause in terms of sites when kind alone loses information, such as
+	// the duplicate pairs of an s2delaunay.DuplicateError.
+	detail string
+}
+
+// newConstructionError wraps an error from building a diagram, mapping known s2delaunay
+// sentinels to their voronoi-level counterparts.
+func newConstructionError(op string, cause error) error {
+	e := &constructionError{op: op, cause: cause}
+	switch {
+	case errors.Is(cause, s2delaunay.ErrInsufficientVertices):
+		e.kind = ErrInsufficientSites
+	case errors.Is(cause, s2delaunay.ErrInvalidHull):
+		e.kind = ErrDegenerateSites
+	}
+	var de *s2delaunay.DuplicateError
+	if errors.As(cause, &de) {
+		pairs, more := de.Pairs, ""
+		if len(pairs) > maxDuplicateSites {
+			pairs, more = pairs[:maxDuplicateSites], "..."
+		}
+		e.detail = fmt.Sprintf("%d duplicate sites %v%s", len(de.Pairs), pairs, more)
+	}
+	return e
+}
+
+func (e *constructionError) Error() string {
+	if e.kind != nil && e.detail != "" {
+		return e.op + ": " + e.kind.Error() + ": " + e.detail
+	}
+	if e.kind != nil {
+		return e.op + ": " + e.kind.Error()
+	}
+	return e.op + ": " + e.cause.Error()
+}
+
+func (e *constructionError) Unwrap() []error {
+	if e.kind != nil {
+		return []error{ErrDiagramConstruction, e.kind, e.cause}
+	}
+	return []error{ErrDiagramConstruction, e.cause}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Errors
+
+func TestErrors_Is(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	coplanar := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(0, 1, 0),
+		s2.PointFromCoords(-1, 0, 0), s2.PointFromCoords(0, -1, 0),
+	}
+	call := func(f func() error) error { return f() }
+	tests := []struct {
+		name string
+		err  error
+		want []error
+	}{
+		{"insufficient sites", call(func() error {
+			_, err := NewDiagram(utils.GenerateRandomPoints(3, 0))
+			return err
+		}), []error{ErrDiagramConstruction, ErrInsufficientSites,
+			s2delaunay.ErrInsufficientVertices}},
+		{"coplanar", call(func() error {
+			_, err := NewBarycentricDualDiagram(coplanar)
+			return err
+		}), []error{ErrDiagramConstruction, ErrDegenerateSites, s2delaunay.ErrInvalidHull}},
+		{"rebuild", call(func() error {
+			d := mustNewDiagram(t, 10)
+			return d.Rebuild(utils.GenerateRandomPoints(2, 0))
+		}), []error{ErrDiagramConstruction, ErrInsufficientSites,
+			s2delaunay.ErrInsufficientVertices}},
+		{"density", call(func() error {
+			_, _, err := DensityEstimate(utils.GenerateRandomPoints(3, 0))
+			return err
+		}), []error{ErrDiagramConstruction, ErrInsufficientSites}},
+		{"override", call(func() error {
+			_, err := NewDiagram(utils.GenerateRandomPoints(10, 0),
+				WithVertexOverride(func([3]s2.Point, int) (s2.Point, bool) {
+					return s2.PointFromCoords(1, 0, 0), true
+				}))
+			return err
+		}), []error{ErrDiagramConstruction}},
+		{"eps option", call(func() error {
+			_, err := NewDiagram(utils.GenerateRandomPoints(10, 0), WithEps(0))
+			return err
+		}), []error{ErrInvalidOption}},
+		{"density option", call(func() error {
+			_, _, err := DensityEstimate(utils.GenerateRandomPoints(10, 0),
+				WithSmoothingRings(-1))
+			return err
+		}), []error{ErrInvalidOption}},
+		{"cell", call(func() error {
+			_, err := vd.Cell(10)
+			return err
+		}), []error{ErrOutOfRange}},
+		{"cell vertex", call(func() error {
+			_, err := Cell{idx: 0, d: vd}.Vertex(-1)
+			return err
+		}), []error{ErrOutOfRange}},
+		{"cell neighbor", call(func() error {
+			_, err := Cell{idx: 0, d: vd}.Neighbor(100)
+			return err
+		}), []error{ErrOutOfRange}},
+		{"cell id", call(func() error {
+			_, err := vd.CellByID(vd.CellID(0) + 1)
+			return err
+		}), []error{ErrNotFound}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, want := range tt.want {
+				if !errors.Is(tt.err, want) {
+					t.Errorf("errors.Is(%v, %v) = false, want true", tt.err, want)
+				}
+			}
+			if strings.Contains(tt.err.Error(), "NewTriangulation") {
+				t.Errorf("error %q mentions the triangulation layer", tt.err)
+			}
+		})
+	}
+}
+
+func TestErrors_DuplicateSites(t *testing.T) {
+	sites := utils.GenerateRandomPoints(50, 0)
+	sites = append(sites, sites[0])
+	_, err := NewDiagram(sites)
+	if !errors.Is(err, ErrDegenerateSites) {
+		t.Fatalf("NewDiagram(duplicates) error = %v, want %v", err, ErrDegenerateSites)
+	}
+	var de *s2delaunay.DuplicateError
+	if !errors.As(err, &de) {
+		t.Errorf("errors.As(%v, *DuplicateError) = false, want true", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "1 duplicate sites [[0 50]]") {
+		t.Errorf("err.Error() = %q, want the duplicate pair [0 50]", msg)
+	}
+}
+
+func TestOptionError(t *testing.T) {
+	points := utils.GenerateRandomPoints(10, 0)
+	newDiagram := func(setters ...DiagramOption) error {
+		_, err := NewDiagram(points, setters...)
+		return err
+	}
+	tests := []struct {
+		name   string
+		err    error
+		option string
+	}{
+		{"eps", newDiagram(WithEps(-1)), "WithEps"},
+		{"override", newDiagram(WithVertexOverride(nil)), "WithVertexOverride"},
+		{"override tolerance", newDiagram(WithOverrideTolerance(0)), "WithOverrideTolerance"},
+		{"id level", newDiagram(WithIDLevel(31)), "WithIDLevel"},
+		{"max radius", newDiagram(WithMaxRadius(0)), "WithMaxRadius"},
+		{"metrics", newDiagram(WithMetrics(nil)), "WithMetrics"},
+		{"ring repair conflict", func() error {
+			_, err := NewBarycentricDualDiagram(points, WithRingRepair())
+			return err
+		}(), "WithRingRepair"},
+		{"check", Check(mustNewDiagram(t, 10), WithCheckSamples(-1, 0)).Err, "WithCheckSamples"},
+		{"density", func() error {
+			_, _, err := DensityEstimate(points, WithSphereRadius(0))
+			return err
+		}(), "WithSphereRadius"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oe *OptionError
+			if !errors.As(tt.err, &oe) || oe.Name != tt.option {
+				t.Fatalf("error = %v, want OptionError for %s", tt.err, tt.option)
+			}
+			if !errors.Is(tt.err, ErrInvalidOption) {
+				t.Errorf("errors.Is(%v, ErrInvalidOption) = false, want true", tt.err)
+			}
+		})
+	}
+}
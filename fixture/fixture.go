@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package fixture reads and writes Voronoi diagrams and Delaunay triangulations in a stable,
+// human-diffable text format and compares them against golden files in tests.
+//
+// The format is the one of Diagram.MarshalText and Triangulation.MarshalText: one value per
+// keyword line, hexadecimal floating-point literals that round-trip exactly, and canonical
+// element order and ring rotation, so a fixture only changes when the geometry or topology
+// does. Golden files are regenerated by passing update as true, conventionally from a test
+// flag:
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	func TestMesh(t *testing.T) {
+//		fixture.AssertMatchesGolden(t, d, "testdata/mesh.golden", *update)
+//	}
+
+package fixture
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2dChan/s2voronoi"
+	"github.com/2dChan/s2voronoi/s2delaunay"
+)
+
+// WriteFixture writes the diagram to w in the fixture format.
+func WriteFixture(w io.Writer, d *s2voronoi.Diagram) error {
+	data, err := d.MarshalText()
+	if err != nil {
+		return fmt.Errorf("WriteFixture: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// ReadFixture reads a diagram written by WriteFixture from r.
+// It returns an error if r fails or the fixture is malformed.
+func ReadFixture(r io.Reader) (*s2voronoi.Diagram, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("ReadFixture: %w", err)
+	}
+	d := new(s2voronoi.Diagram)
+	if err := d.UnmarshalText(data); err != nil {
+		return nil, fmt.Errorf("ReadFixture: %w", err)
+	}
+	return d, nil
+}
+
+// WriteTriangulationFixture writes the triangulation to w in the fixture format.
+func WriteTriangulationFixture(w io.Writer, t *s2delaunay.Triangulation) error {
+	data, err := t.MarshalText()
+	if err != nil {
+		return fmt.Errorf("WriteTriangulationFixture: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// ReadTriangulationFixture reads a triangulation written by WriteTriangulationFixture from r.
+// It returns an error if r fails or the fixture is malformed.
+func ReadTriangulationFixture(r io.Reader) (*s2delaunay.Triangulation, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("ReadTriangulationFixture: %w", err)
+	}
+	t := new(s2delaunay.Triangulation)
+	if err := t.UnmarshalText(data); err != nil {
+		return nil, fmt.Errorf("ReadTriangulationFixture: %w", err)
+	}
+	return t, nil
+}
+
+// AssertMatchesGolden fails the test if the fixture of d differs from the golden file at path.
+// If update is true, the golden file and its directory are created or overwritten instead.
+func AssertMatchesGolden(t testing.TB, d *s2voronoi.Diagram, path string, update bool) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := WriteFixture(&buf, d); err != nil {
+		t.Fatalf("WriteFixture(...) error = %v, want nil", err)
+	}
+	if err := matchGolden(buf.Bytes(), path, update); err != nil {
+		t.Error(err)
+	}
+}
+
+// AssertTriangulationMatchesGolden fails the test if the fixture of tr differs from the golden
+// file at path. If update is true, the golden file and its directory are created or overwritten
+// instead.
+func AssertTriangulationMatchesGolden(t testing.TB, tr *s2delaunay.Triangulation, path string,
+	update bool) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := WriteTriangulationFixture(&buf, tr); err != nil {
+		t.Fatalf("WriteTriangulationFixture(...) error = %v, want nil", err)
+	}
+	if err := matchGolden(buf.Bytes(), path, update); err != nil {
+		t.Error(err)
+	}
+}
+
+// matchGolden compares got with the golden file at path, or writes it there if update is true.
+// The error for a mismatch reports the first differing line.
+func matchGolden(got []byte, path string, update bool) error {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, got, 0o644)
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("golden file %s: %w (rerun with update to create it)", path, err)
+	}
+	if bytes.Equal(got, want) {
+		return nil
+	}
+	gotLines := bytes.Split(got, []byte("\n"))
+	wantLines := bytes.Split(want, []byte("\n"))
+	line := 0
+	for line < len(gotLines) && line < len(wantLines) &&
+		bytes.Equal(gotLines[line], wantLines[line]) {
+		line++
+	}
+	var g, w []byte
+	if line < len(gotLines) {
+		g = gotLines[line]
+	}
+	if line < len(wantLines) {
+		w = wantLines[line]
+	}
+	return fmt.Errorf("golden file %s differs at line %d:\n  want %q\n  got  %q", path, line+1,
+		w, g)
+}
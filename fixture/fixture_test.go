@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package fixture
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/2dChan/s2voronoi"
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// Fixture
+
+func TestFixture_Diagram(t *testing.T) {
+	d, err := s2voronoi.NewDiagram(utils.GenerateRandomPoints(100, 0))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	var first bytes.Buffer
+	if err := WriteFixture(&first, d); err != nil {
+		t.Fatalf("WriteFixture(...) error = %v, want nil", err)
+	}
+	got, err := ReadFixture(bytes.NewReader(first.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadFixture(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(d.Sites, got.Sites); diff != "" {
+		t.Errorf("got.Sites mismatch (-want +got):\n%s", diff)
+	}
+	for i := range d.NumCells() {
+		want, _ := d.Cell(i)
+		c, _ := got.Cell(i)
+		diff := cmp.Diff(cellPoints(want), cellPoints(c), cmpopts.SortSlices(lessPoint))
+		if diff != "" {
+			t.Errorf("cell %d vertices mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+
+	var second bytes.Buffer
+	if err := WriteFixture(&second, got); err != nil {
+		t.Fatalf("WriteFixture(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(first.String(), second.String()); diff != "" {
+		t.Errorf("WriteFixture(ReadFixture(...)) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFixture_Triangulation(t *testing.T) {
+	tr, err := s2delaunay.NewTriangulation(utils.GenerateRandomPoints(100, 0))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	var first bytes.Buffer
+	if err := WriteTriangulationFixture(&first, tr); err != nil {
+		t.Fatalf("WriteTriangulationFixture(...) error = %v, want nil", err)
+	}
+	got, err := ReadTriangulationFixture(bytes.NewReader(first.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadTriangulationFixture(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(tr.Vertices, got.Vertices); diff != "" {
+		t.Errorf("got.Vertices mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(tr.IncidentTriangleOffsets, got.IncidentTriangleOffsets); diff != "" {
+		t.Errorf("got.IncidentTriangleOffsets mismatch (-want +got):\n%s", diff)
+	}
+
+	var second bytes.Buffer
+	if err := WriteTriangulationFixture(&second, got); err != nil {
+		t.Fatalf("WriteTriangulationFixture(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(first.String(), second.String()); diff != "" {
+		t.Errorf("WriteTriangulationFixture(ReadTriangulationFixture(...)) mismatch "+
+			"(-want +got):\n%s", diff)
+	}
+
+	if _, err := ReadTriangulationFixture(strings.NewReader("garbage\n")); err == nil {
+		t.Errorf("ReadTriangulationFixture(garbage) error = nil, want non-nil")
+	}
+}
+
+func TestAssertMatchesGolden(t *testing.T) {
+	d, err := s2voronoi.NewDiagram(utils.GenerateRandomPoints(20, 0))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	tr, err := s2delaunay.NewTriangulation(utils.GenerateRandomPoints(20, 0))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	dir := t.TempDir()
+	diagramPath := filepath.Join(dir, "testdata", "diagram.golden")
+	triangulationPath := filepath.Join(dir, "testdata", "triangulation.golden")
+
+	AssertMatchesGolden(t, d, diagramPath, true)
+	AssertMatchesGolden(t, d, diagramPath, false)
+	AssertTriangulationMatchesGolden(t, tr, triangulationPath, true)
+	AssertTriangulationMatchesGolden(t, tr, triangulationPath, false)
+
+	var buf bytes.Buffer
+	if err := WriteFixture(&buf, d); err != nil {
+		t.Fatalf("WriteFixture(...) error = %v, want nil", err)
+	}
+	changed := bytes.Replace(buf.Bytes(), []byte("idlevel 30"), []byte("idlevel 29"), 1)
+	if err := os.WriteFile(diagramPath, changed, 0o644); err != nil {
+		t.Fatalf("os.WriteFile(...) error = %v, want nil", err)
+	}
+	err = matchGolden(buf.Bytes(), diagramPath, false)
+	if err == nil || !strings.Contains(err.Error(), "line 6") {
+		t.Errorf("matchGolden(...) error = %v, want mismatch at line 6", err)
+	}
+	if err := matchGolden(buf.Bytes(), filepath.Join(dir, "missing"), false); err == nil {
+		t.Errorf("matchGolden(missing) error = nil, want non-nil")
+	}
+}
+
+func cellPoints(c s2voronoi.Cell) []s2.Point {
+	points := make([]s2.Point, c.NumVertices())
+	for k := range points {
+		points[k], _ = c.Vertex(k)
+	}
+	return points
+}
+
+func lessPoint(a, b s2.Point) bool {
+	if a.X != b.X {
+		return a.X < b.X
+	}
+	if a.Y != b.Y {
+		return a.Y < b.Y
+	}
+	return a.Z < b.Z
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Float32Buffers holds a diagram converted to the float32 and uint32 arrays consumed by
+// rendering pipelines. Rings index a single shared vertex array, so adjacent cells reference
+// bit-identical coordinates for their common vertices and polygons rendered from them leave no
+// cracks.
+type Float32Buffers struct {
+	// Sites holds the x, y and z coordinates of every site.
+	Sites []float32
+	// Vertices holds the x, y and z coordinates of every Voronoi vertex.
+	Vertices []float32
+	// CellVertices, CellNeighbors and CellOffsets mirror the fields of the Diagram.
+	CellVertices  []uint32
+	CellNeighbors []uint32
+	CellOffsets   []uint32
+	// MaxError is the largest angle between a converted point and its original.
+	MaxError s1.Angle
+}
+
+// Float32Options holds configuration options for float32 conversion.
+type Float32Options struct {
+	// Renormalize rescales converted points to unit length in float32 precision.
+	Renormalize bool
+}
+
+// Float32Option is a functional option type for float32 conversion configuration.
+type Float32Option func(*Float32Options) error
+
+// WithRenormalize rescales every converted point to unit length in float32 precision, for
+// shaders that assume unit vectors. Rounding the coordinates alone leaves their norm within
+// about 1e-7 of 1.
+func WithRenormalize() Float32Option {
+	return func(o *Float32Options) error {
+		o.Renormalize = true
+		return nil
+	}
+}
+
+// Float32Buffers converts the sites, vertices and ring arrays of the diagram to float32 and
+// uint32, and reports the largest angular error introduced by the conversion.
+// It returns an error if an option is invalid or an index does not fit in uint32.
+func (d *Diagram) Float32Buffers(setters ...Float32Option) (Float32Buffers, error) {
+	var opts Float32Options
+	for _, set := range setters {
+		err := set(&opts)
+		if err != nil {
+			return Float32Buffers{}, err
+		}
+	}
+
+	var b Float32Buffers
+	b.Sites, b.MaxError = float32Points(d.Sites, opts.Renormalize, b.MaxError)
+	b.Vertices, b.MaxError = float32Points(d.Vertices, opts.Renormalize, b.MaxError)
+	var err error
+	for _, s := range []struct {
+		dst *[]uint32
+		src []int
+	}{
+		{&b.CellVertices, d.CellVertices},
+		{&b.CellNeighbors, d.CellNeighbors},
+		{&b.CellOffsets, d.CellOffsets},
+	} {
+		if *s.dst, err = uint32Indices(s.src); err != nil {
+			return Float32Buffers{}, fmt.Errorf("Float32Buffers: %w", err)
+		}
+	}
+	return b, nil
+}
+
+// float32Points converts the points to interleaved float32 coordinates, returning them with
+// the maximum of maxErr and the angular errors of the conversion.
+func float32Points(points s2.PointVector, renormalize bool, maxErr s1.Angle) ([]float32,
+	s1.Angle) {
+	out := make([]float32, 3*len(points))
+	for i, p := range points {
+		x, y, z := float32(p.X), float32(p.Y), float32(p.Z)
+		if renormalize {
+			n := float32(math.Sqrt(float64(x*x + y*y + z*z)))
+			x, y, z = x/n, y/n, z/n
+		}
+		out[3*i], out[3*i+1], out[3*i+2] = x, y, z
+		q := r3.Vector{X: float64(x), Y: float64(y), Z: float64(z)}
+		maxErr = max(maxErr, p.Vector.Angle(q))
+	}
+	return out, maxErr
+}
+
+// uint32Indices converts the indices to uint32.
+// It returns an error if an index does not fit.
+func uint32Indices(s []int) ([]uint32, error) {
+	out := make([]uint32, len(s))
+	for i, v := range s {
+		if v < 0 || v > math.MaxUint32 {
+			return nil, fmt.Errorf("index %d at %d does not fit in uint32", v, i)
+		}
+		out[i] = uint32(v)
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
+)
+
+// Float32
+
+func TestDiagram_Float32Buffers(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	for _, renormalize := range []bool{false, true} {
+		var setters []Float32Option
+		if renormalize {
+			setters = append(setters, WithRenormalize())
+		}
+		b, err := vd.Float32Buffers(setters...)
+		if err != nil {
+			t.Fatalf("vd.Float32Buffers(...) error = %v, want nil", err)
+		}
+		if b.MaxError <= 0 || b.MaxError > 1e-6 {
+			t.Errorf("renormalize=%v: b.MaxError = %v, want in (0, 1e-6]", renormalize, b.MaxError)
+		}
+
+		point := func(buf []float32, i int) r3.Vector {
+			return r3.Vector{X: float64(buf[3*i]), Y: float64(buf[3*i+1]), Z: float64(buf[3*i+2])}
+		}
+		for i, p := range vd.Vertices {
+			q := point(b.Vertices, i)
+			if e := s1.Angle(p.Angle(q)); e > b.MaxError {
+				t.Errorf("vertex %d error %v exceeds b.MaxError %v", i, e, b.MaxError)
+			}
+			if renormalize && math.Abs(q.Norm()-1) > 2e-7 {
+				t.Errorf("vertex %d norm = %v, want 1", i, q.Norm())
+			}
+		}
+
+		for i := range vd.NumCells() {
+			start, end := b.CellOffsets[i], b.CellOffsets[i+1]
+			for k := start; k < end; k++ {
+				j := b.CellNeighbors[k]
+				// The edge to neighbor k runs from vertex k to vertex k+1; the neighbor must
+				// reference both with bit-identical coordinates.
+				next := start + (k-start+1)%(end-start)
+				for _, v := range []uint32{b.CellVertices[k], b.CellVertices[next]} {
+					ring := b.CellVertices[b.CellOffsets[j]:b.CellOffsets[j+1]]
+					if !slices.Contains(ring, v) {
+						t.Fatalf("cell %d: vertex %d missing from neighbor %d", i, v, j)
+					}
+				}
+			}
+		}
+		if got := b.CellOffsets[len(b.CellOffsets)-1]; int(got) != len(vd.CellVertices) {
+			t.Errorf("b.CellOffsets ends at %d, want %d", got, len(vd.CellVertices))
+		}
+	}
+}
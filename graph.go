@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+
+	"github.com/golang/geo/s1"
+)
+
+// SitesWithinAngle returns the sites reachable from site i through the neighbor graph along a
+// path of total geodesic length at most maxAngle, with the length of their shortest path in
+// the parallel distances slice. Site i itself is included at distance 0. Results are ordered
+// by distance, then by index. Because paths follow the neighbor graph, sites that are close
+// as the crow flies but only reachable through a long detour are excluded.
+// It returns nil slices if i is out of range, maxAngle is negative or the diagram was built
+// WithoutNeighbors.
+func (d *Diagram) SitesWithinAngle(i int, maxAngle s1.Angle) (sites []int, distances []s1.Angle) {
+	if i < 0 || i >= d.NumCells() || maxAngle < 0 || !d.hasNeighbors() {
+		return nil, nil
+	}
+
+	dist := map[int]s1.Angle{i: 0}
+	done := make(map[int]bool)
+	pq := &siteQueue{{site: i}}
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(siteDistance)
+		if done[cur.site] {
+			continue
+		}
+		done[cur.site] = true
+		sites = append(sites, cur.site)
+		for _, n := range (Cell{idx: cur.site, d: d}).NeighborIndices() {
+			nd := cur.dist + d.Sites[cur.site].Distance(d.Sites[n])
+			if nd > maxAngle || done[n] {
+				continue
+			}
+			if old, ok := dist[n]; ok && old <= nd {
+				continue
+			}
+			dist[n] = nd
+			heap.Push(pq, siteDistance{site: n, dist: nd})
+		}
+	}
+
+	// Pops are ordered by distance already; sorting fixes the order of exact ties.
+	slices.SortFunc(sites, func(a, b int) int {
+		return cmp.Or(cmp.Compare(dist[a], dist[b]), cmp.Compare(a, b))
+	})
+	distances = make([]s1.Angle, len(sites))
+	for k, s := range sites {
+		distances[k] = dist[s]
+	}
+	return sites, distances
+}
+
+// siteDistance is a site with a tentative path length.
+type siteDistance struct {
+	site int
+	dist s1.Angle
+}
+
+// siteQueue is a min-heap of sites ordered by distance, then by index.
+type siteQueue []siteDistance
+
+func (q siteQueue) Len() int { return len(q) }
+
+func (q siteQueue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	return q[i].site < q[j].site
+}
+
+func (q siteQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *siteQueue) Push(x any) { *q = append(*q, x.(siteDistance)) }
+
+func (q *siteQueue) Pop() any {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/s1"
+)
+
+// Graph
+
+func TestDiagram_SitesWithinAngle(t *testing.T) {
+	vd := mustNewDiagram(t, 300)
+	const maxAngle = s1.Angle(0.5)
+	for _, i := range []int{0, 17, 123} {
+		sites, distances := vd.SitesWithinAngle(i, maxAngle)
+		if len(sites) != len(distances) || sites[0] != i || distances[0] != 0 {
+			t.Fatalf("vd.SitesWithinAngle(%d, ...) = %v, %v, want parallel slices starting at %d",
+				i, sites, distances, i)
+		}
+
+		want := graphDistancesBruteForce(vd, i)
+		for k, s := range sites {
+			if math.Abs(float64(distances[k]-want[s])) > 1e-12 {
+				t.Errorf("distance to %d = %v, want %v", s, distances[k], want[s])
+			}
+			if k > 0 && (distances[k] < distances[k-1] ||
+				distances[k] == distances[k-1] && s < sites[k-1]) {
+				t.Errorf("results not ordered by distance then index at %d", k)
+			}
+		}
+		for s, dist := range want {
+			if dist <= maxAngle && !slices.Contains(sites, s) {
+				t.Errorf("site %d at graph distance %v missing", s, dist)
+			}
+		}
+
+		// Graph paths are never shorter than geodesics, and a Delaunay triangulation is a
+		// spanner with stretch below 2, so nearby sites are always reached.
+		for s := range vd.Sites {
+			gc := vd.Sites[i].Distance(vd.Sites[s])
+			in := slices.Contains(sites, s)
+			if in && gc > maxAngle {
+				t.Errorf("site %d at great-circle distance %v exceeds budget", s, gc)
+			}
+			if !in && gc <= maxAngle/2 {
+				t.Errorf("site %d at great-circle distance %v not reached", s, gc)
+			}
+		}
+	}
+
+	if sites, _ := vd.SitesWithinAngle(-1, maxAngle); sites != nil {
+		t.Errorf("vd.SitesWithinAngle(-1, ...) = %v, want nil", sites)
+	}
+	if sites, _ := vd.SitesWithinAngle(0, 0); !slices.Equal(sites, []int{0}) {
+		t.Errorf("vd.SitesWithinAngle(0, 0) = %v, want [0]", sites)
+	}
+}
+
+// graphDistancesBruteForce computes shortest path lengths from site i over the neighbor graph
+// by Bellman-Ford relaxation.
+func graphDistancesBruteForce(vd *Diagram, i int) []s1.Angle {
+	dist := make([]s1.Angle, vd.NumCells())
+	for j := range dist {
+		dist[j] = s1.InfAngle()
+	}
+	dist[i] = 0
+	for changed := true; changed; {
+		changed = false
+		for a := range vd.NumCells() {
+			for _, b := range (Cell{idx: a, d: vd}).NeighborIndices() {
+				if nd := dist[a] + vd.Sites[a].Distance(vd.Sites[b]); nd < dist[b] {
+					dist[b] = nd
+					changed = true
+				}
+			}
+		}
+	}
+	return dist
+}
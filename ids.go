@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/golang/geo/s2"
+)
+
+// CellID returns a stable ID for the cell at the given index, derived from the S2 cell ID of its
+// site at the configured ID level. It does not depend on the site index, so it survives rebuilds
+// in which the site did not leave its S2 cell. It panics if i is out of range.
+func (d *Diagram) CellID(i int) uint64 {
+	id := s2.CellFromPoint(d.Sites[i]).ID().Parent(d.opts.IDLevel)
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(id))
+	h := fnv.New64a()
+	h.Write(b[:])
+	return h.Sum64()
+}
+
+// CellByID returns the cell with the given ID. The lookup map is built on first use and
+// discarded by InvalidateCaches.
+// It returns an error if no cell has the ID or if two cells share an ID, which happens when the
+// ID level is too coarse for the site spacing.
+func (d *Diagram) CellByID(id uint64) (Cell, error) {
+	ids, err := d.cellIDIndex()
+	if err != nil {
+		return Cell{}, err
+	}
+	i, ok := ids[id]
+	if !ok {
+		return Cell{}, fmt.Errorf("CellByID: id %#x %w", id, ErrNotFound)
+	}
+	return Cell{idx: i, d: d}, nil
+}
+
+// cellIDIndex returns the map from cell ID to cell index, building it on first use.
+func (d *Diagram) cellIDIndex() (map[uint64]int, error) {
+	c := d.caches()
+	c.idsOnce.Do(func() {
+		c.cellIDs = make(map[uint64]int, d.NumCells())
+		for i := range d.NumCells() {
+			cid := d.CellID(i)
+			if j, ok := c.cellIDs[cid]; ok {
+				c.idsErr = fmt.Errorf("CellByID: %w: cells %d and %d share id %#x", ErrIDCollision,
+					j, i, cid)
+				return
+			}
+			c.cellIDs[cid] = i
+		}
+	})
+	return c.cellIDs, c.idsErr
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// IDs
+
+func TestWithIDLevel(t *testing.T) {
+	for _, level := range []int{-1, s2.MaxLevel + 1} {
+		if err := WithIDLevel(level)(&DiagramOptions{}); err == nil {
+			t.Errorf("WithIDLevel(%d) error = nil, want non-nil", level)
+		}
+	}
+	opts := &DiagramOptions{}
+	if err := WithIDLevel(10)(opts); err != nil || opts.IDLevel != 10 {
+		t.Errorf("WithIDLevel(10) = %v, opts.IDLevel = %d, want nil, 10", err, opts.IDLevel)
+	}
+}
+
+func TestDiagram_CellByID_Rebuild(t *testing.T) {
+	sites := utils.GenerateRandomPoints(100, 0)
+	vd, err := NewDiagram(sites)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	ids := make([]uint64, vd.NumCells())
+	for i := range ids {
+		ids[i] = vd.CellID(i)
+	}
+
+	// Reverse the sites and move the first one away; all other cells keep their IDs.
+	rebuilt := slices.Clone(sites)
+	slices.Reverse(rebuilt)
+	last := len(rebuilt) - 1
+	moved := rebuilt[last].Add(s2.Ortho(rebuilt[last]).Mul(0.01))
+	rebuilt[last] = s2.Point{Vector: moved.Normalize()}
+	if err := vd.Rebuild(rebuilt); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+
+	if _, err := vd.CellByID(ids[0]); err == nil {
+		t.Errorf("vd.CellByID(ids[0]) error = nil, want non-nil for moved site")
+	}
+	for i := 1; i < len(ids); i++ {
+		c, err := vd.CellByID(ids[i])
+		if err != nil {
+			t.Fatalf("vd.CellByID(ids[%d]) error = %v, want nil", i, err)
+		}
+		if want := last - i; c.SiteIndex() != want {
+			t.Errorf("vd.CellByID(ids[%d]).SiteIndex() = %d, want %d", i, c.SiteIndex(), want)
+		}
+	}
+}
+
+func TestDiagram_CellByID_Collision(t *testing.T) {
+	vd, err := NewDiagram(utils.GenerateRandomPoints(100, 0), WithIDLevel(0))
+	if err != nil {
+		t.Fatalf("NewDiagram(..., WithIDLevel(0)) error = %v, want nil", err)
+	}
+	if _, err := vd.CellByID(vd.CellID(0)); err == nil {
+		t.Errorf("vd.CellByID(...) error = nil, want collision error")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package buildclock measures the phases and allocations of a build for its metrics.
+
+package buildclock
+
+import (
+	"runtime"
+	"time"
+)
+
+// Clock measures consecutive build phases and the allocations made since it started.
+type Clock struct {
+	start, last time.Time
+	mem         runtime.MemStats
+}
+
+// Start returns a clock started now.
+func Start() *Clock {
+	c := new(Clock)
+	runtime.ReadMemStats(&c.mem)
+	c.start = time.Now()
+	c.last = c.start
+	return c
+}
+
+// Lap returns the time since the previous lap, or since the start for the first one.
+func (c *Clock) Lap() time.Duration {
+	now := time.Now()
+	d := now.Sub(c.last)
+	c.last = now
+	return d
+}
+
+// Finish returns the total time and the number of allocations and bytes allocated since the
+// start.
+func (c *Clock) Finish() (time.Duration, uint64, uint64) {
+	total := time.Since(c.start)
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return total, mem.Mallocs - c.mem.Mallocs, mem.TotalAlloc - c.mem.TotalAlloc
+}
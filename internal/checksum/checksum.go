@@ -0,0 +1,28 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package checksum fingerprints point sets to detect modification after construction.
+
+package checksum
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+
+	"github.com/golang/geo/s2"
+)
+
+// Points returns the 64-bit FNV-1a hash of the point coordinates.
+func Points(points s2.PointVector) uint64 {
+	h := fnv.New64a()
+	var b [24]byte
+	for _, p := range points {
+		binary.LittleEndian.PutUint64(b[0:], math.Float64bits(p.X))
+		binary.LittleEndian.PutUint64(b[8:], math.Float64bits(p.Y))
+		binary.LittleEndian.PutUint64(b[16:], math.Float64bits(p.Z))
+		h.Write(b[:])
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package npy writes arrays in the NumPy .npy format and bundles them into .npz archives.
+
+package npy
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/s2"
+)
+
+// headerAlign is the alignment of the data section required by the .npy format.
+const headerAlign = 64
+
+// Array is a named array stored in an .npz archive. Exactly one of Float64 and Int32 is set.
+type Array struct {
+	Name    string
+	Shape   []int
+	Float64 []float64
+	Int32   []int32
+}
+
+// Int32s converts ints to int32 values.
+// It returns an error if a value does not fit in int32.
+func Int32s(s []int) ([]int32, error) {
+	out := make([]int32, len(s))
+	for i, v := range s {
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return nil, fmt.Errorf("Int32s: value %d at %d overflows int32", v, i)
+		}
+		out[i] = int32(v)
+	}
+	return out, nil
+}
+
+// Points returns the coordinates of the points flattened in x, y, z order, for an n×3 array.
+func Points(points s2.PointVector) []float64 {
+	coords := make([]float64, 0, 3*len(points))
+	for _, p := range points {
+		coords = append(coords, p.X, p.Y, p.Z)
+	}
+	return coords
+}
+
+// WriteNPZ writes the arrays to w as an uncompressed .npz archive, one <Name>.npy entry each.
+func WriteNPZ(w io.Writer, arrays []Array) error {
+	zw := zip.NewWriter(w)
+	for _, a := range arrays {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: a.Name + ".npy", Method: zip.Store})
+		if err != nil {
+			return err
+		}
+		if err := Write(f, a); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// Write writes a single array to w in .npy version 1.0 format, little endian and C order.
+// It returns an error if the shape does not match the number of elements.
+func Write(w io.Writer, a Array) error {
+	var descr string
+	var n int
+	var data any
+	switch {
+	case a.Float64 != nil && a.Int32 == nil:
+		descr, n, data = "<f8", len(a.Float64), a.Float64
+	case a.Int32 != nil && a.Float64 == nil:
+		descr, n, data = "<i4", len(a.Int32), a.Int32
+	default:
+		return fmt.Errorf("Write: array %q must have exactly one data slice", a.Name)
+	}
+	size := 1
+	for _, s := range a.Shape {
+		size *= s
+	}
+	if size != n {
+		return fmt.Errorf("Write: array %q shape %v does not match %d elements", a.Name, a.Shape, n)
+	}
+
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }", descr,
+		shapeTuple(a.Shape))
+	// Magic (6), version (2) and header length (2) precede the header, which is padded with
+	// spaces and terminated by a newline.
+	pad := headerAlign - (10+len(header)+1)%headerAlign
+	if pad == headerAlign {
+		pad = 0
+	}
+	header += strings.Repeat(" ", pad) + "\n"
+	if len(header) > math.MaxUint16 {
+		return errors.New("Write: header too long")
+	}
+
+	prefix := []byte{0x93, 'N', 'U', 'M', 'P', 'Y', 1, 0, 0, 0}
+	binary.LittleEndian.PutUint16(prefix[8:], uint16(len(header)))
+	if _, err := w.Write(prefix); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, data)
+}
+
+// shapeTuple formats a shape as a Python tuple literal.
+func shapeTuple(shape []int) string {
+	parts := make([]string, len(shape))
+	for i, s := range shape {
+		parts[i] = strconv.Itoa(s)
+	}
+	if len(parts) == 1 {
+		return "(" + parts[0] + ",)"
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
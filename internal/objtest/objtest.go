@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package objtest parses the Wavefront OBJ output of the WriteOBJ methods in tests.
+
+package objtest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/golang/geo/r3"
+)
+
+// Parse returns the vertices and the 1-based triangular faces of the OBJ data in r, failing
+// the test on any other line.
+func Parse(t testing.TB, r io.Reader) ([]r3.Vector, [][3]int) {
+	t.Helper()
+	var vertices []r3.Vector
+	var faces [][3]int
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		var v r3.Vector
+		var f [3]int
+		if _, err := fmt.Sscanf(sc.Text(), "v %g %g %g", &v.X, &v.Y, &v.Z); err == nil {
+			vertices = append(vertices, v)
+		} else if _, err := fmt.Sscanf(sc.Text(), "f %d %d %d", &f[0], &f[1], &f[2]); err == nil {
+			faces = append(faces, f)
+		} else {
+			t.Fatalf("unexpected line %q", sc.Text())
+		}
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("reading OBJ data: %v", err)
+	}
+	return vertices, faces
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package text implements the line-oriented primitives of the textual fixture formats.
+// Every line is a keyword followed by space-separated values, and floating-point values are
+// written as hexadecimal literals so that they round-trip exactly.
+
+package text
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// ErrTruncated reports data that ends before a value is complete.
+var ErrTruncated = errors.New("truncated data")
+
+// Writer appends lines to a buffer.
+type Writer struct {
+	buf bytes.Buffer
+}
+
+// Bytes returns the encoded data.
+func (w *Writer) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+// Int appends a line with the keyword and v.
+func (w *Writer) Int(key string, v int) {
+	fmt.Fprintf(&w.buf, "%s %d\n", key, v)
+}
+
+// Float64 appends a line with the keyword and v in hexadecimal.
+func (w *Writer) Float64(key string, v float64) {
+	fmt.Fprintf(&w.buf, "%s %s\n", key, strconv.FormatFloat(v, 'x', -1, 64))
+}
+
+// Ints appends a line with the keyword and the values.
+func (w *Writer) Ints(key string, values []int) {
+	w.buf.WriteString(key)
+	for _, v := range values {
+		w.buf.WriteByte(' ')
+		w.buf.WriteString(strconv.Itoa(v))
+	}
+	w.buf.WriteByte('\n')
+}
+
+// Points appends a line with the keyword and the number of points, followed by one line per
+// point with its coordinates in hexadecimal.
+func (w *Writer) Points(key string, points s2.PointVector) {
+	w.Int(key, len(points))
+	for _, p := range points {
+		fmt.Fprintf(&w.buf, "%s %s %s\n", strconv.FormatFloat(p.X, 'x', -1, 64),
+			strconv.FormatFloat(p.Y, 'x', -1, 64), strconv.FormatFloat(p.Z, 'x', -1, 64))
+	}
+}
+
+// Reader consumes lines from a byte slice. After the first failure every method returns zero
+// values and Err reports the failure.
+type Reader struct {
+	lines []string
+	line  int
+	err   error
+}
+
+// NewReader returns a Reader over data.
+func NewReader(data []byte) *Reader {
+	lines := strings.Split(string(data), "\n")
+	if n := len(lines); n > 0 && lines[n-1] == "" {
+		lines = lines[:n-1]
+	}
+	return &Reader{lines: lines}
+}
+
+// Err returns the first error encountered.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Close returns the first error encountered, or an error if unread lines remain.
+func (r *Reader) Close() error {
+	if r.err == nil && r.line != len(r.lines) {
+		return fmt.Errorf("%d trailing lines", len(r.lines)-r.line)
+	}
+	return r.err
+}
+
+// fields returns the values of the next line, which must start with the keyword.
+func (r *Reader) fields(key string) []string {
+	if r.err != nil {
+		return nil
+	}
+	if r.line == len(r.lines) {
+		r.err = ErrTruncated
+		return nil
+	}
+	f := strings.Fields(r.lines[r.line])
+	r.line++
+	if len(f) == 0 || f[0] != key {
+		r.err = fmt.Errorf("line %d: want keyword %q", r.line, key)
+		return nil
+	}
+	return f[1:]
+}
+
+// fail records a malformed value on the current line.
+func (r *Reader) fail(err error) {
+	if r.err == nil {
+		r.err = fmt.Errorf("line %d: %w", r.line, err)
+	}
+}
+
+// Int reads a line with the keyword and a single integer.
+func (r *Reader) Int(key string) int {
+	f := r.fields(key)
+	if r.err != nil {
+		return 0
+	}
+	if len(f) != 1 {
+		r.fail(fmt.Errorf("want 1 value got %d", len(f)))
+		return 0
+	}
+	v, err := strconv.Atoi(f[0])
+	if err != nil {
+		r.fail(err)
+	}
+	return v
+}
+
+// Float64 reads a line with the keyword and a single floating-point value.
+func (r *Reader) Float64(key string) float64 {
+	f := r.fields(key)
+	if r.err != nil {
+		return 0
+	}
+	if len(f) != 1 {
+		r.fail(fmt.Errorf("want 1 value got %d", len(f)))
+		return 0
+	}
+	v, err := strconv.ParseFloat(f[0], 64)
+	if err != nil {
+		r.fail(err)
+	}
+	return v
+}
+
+// Ints reads a line with the keyword and any number of integers.
+func (r *Reader) Ints(key string) []int {
+	f := r.fields(key)
+	if r.err != nil {
+		return nil
+	}
+	values := make([]int, len(f))
+	for i, s := range f {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			r.fail(err)
+			return nil
+		}
+		values[i] = v
+	}
+	return values
+}
+
+// Points reads a line with the keyword and a count, followed by that many coordinate lines.
+// The coordinates are kept bit for bit.
+func (r *Reader) Points(key string) s2.PointVector {
+	n := r.Int(key)
+	if r.err == nil && (n < 0 || n > len(r.lines)-r.line) {
+		r.err = ErrTruncated
+	}
+	if r.err != nil {
+		return nil
+	}
+	points := make(s2.PointVector, n)
+	for i := range points {
+		f := strings.Fields(r.lines[r.line])
+		r.line++
+		if len(f) != 3 {
+			r.fail(fmt.Errorf("want 3 coordinates got %d", len(f)))
+			return nil
+		}
+		var c [3]float64
+		for j, s := range f {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				r.fail(err)
+				return nil
+			}
+			c[j] = v
+		}
+		points[i] = s2.Point{Vector: r3.Vector{X: c[0], Y: c[1], Z: c[2]}}
+	}
+	return points
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+// Package wire implements the little-endian primitives of the binary serialization formats.
+
+package wire
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// ErrTruncated reports data that ends before a value is complete.
+var ErrTruncated = errors.New("truncated data")
+
+// Writer appends values to a byte slice.
+type Writer struct {
+	buf []byte
+}
+
+// Bytes returns the encoded data.
+func (w *Writer) Bytes() []byte {
+	return w.buf
+}
+
+// Uint32 appends v.
+func (w *Writer) Uint32(v uint32) {
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, v)
+}
+
+// Float64 appends v.
+func (w *Writer) Float64(v float64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, math.Float64bits(v))
+}
+
+// Uvarint appends v as a variable-length unsigned integer.
+func (w *Writer) Uvarint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+// Ints appends the length of s followed by its values as uint32.
+// It returns an error if a value does not fit in uint32.
+func (w *Writer) Ints(s []int) error {
+	w.Uint32(uint32(len(s)))
+	for i, v := range s {
+		if v < 0 || v > math.MaxUint32 {
+			return fmt.Errorf("Ints: value %d at %d does not fit in uint32", v, i)
+		}
+		w.Uint32(uint32(v))
+	}
+	return nil
+}
+
+// Points appends the number of points followed by their coordinates.
+func (w *Writer) Points(points s2.PointVector) {
+	w.Uint32(uint32(len(points)))
+	for _, p := range points {
+		w.Float64(p.X)
+		w.Float64(p.Y)
+		w.Float64(p.Z)
+	}
+}
+
+// Reader consumes values from a byte slice. After the first failure every method returns zero
+// values and Err reports the failure.
+type Reader struct {
+	buf []byte
+	err error
+}
+
+// NewReader returns a Reader over data.
+func NewReader(data []byte) *Reader {
+	return &Reader{buf: data}
+}
+
+// Err returns the first error encountered.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Close returns the first error encountered, or an error if unread data remains.
+func (r *Reader) Close() error {
+	if r.err == nil && len(r.buf) != 0 {
+		return fmt.Errorf("%d trailing bytes", len(r.buf))
+	}
+	return r.err
+}
+
+// Uint32 reads a uint32.
+func (r *Reader) Uint32() uint32 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) < 4 {
+		r.err = ErrTruncated
+		return 0
+	}
+	v := binary.LittleEndian.Uint32(r.buf)
+	r.buf = r.buf[4:]
+	return v
+}
+
+// Float64 reads a float64.
+func (r *Reader) Float64() float64 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) < 8 {
+		r.err = ErrTruncated
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(r.buf))
+	r.buf = r.buf[8:]
+	return v
+}
+
+// Uvarint reads a variable-length unsigned integer.
+func (r *Reader) Uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = ErrTruncated
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+// Len returns the number of unread bytes.
+func (r *Reader) Len() int {
+	return len(r.buf)
+}
+
+// Ints reads a length-prefixed slice of uint32 values.
+func (r *Reader) Ints() []int {
+	n := int(r.Uint32())
+	if r.err == nil && len(r.buf) < 4*n {
+		r.err = ErrTruncated
+	}
+	if r.err != nil {
+		return nil
+	}
+	s := make([]int, n)
+	for i := range s {
+		s[i] = int(r.Uint32())
+	}
+	return s
+}
+
+// Points reads a count-prefixed slice of points.
+func (r *Reader) Points() s2.PointVector {
+	n := int(r.Uint32())
+	if r.err == nil && len(r.buf) < 24*n {
+		r.err = ErrTruncated
+	}
+	if r.err != nil {
+		return nil
+	}
+	points := make(s2.PointVector, n)
+	for i := range points {
+		x, y, z := r.Float64(), r.Float64(), r.Float64()
+		points[i] = s2.Point{Vector: r3.Vector{X: x, Y: y, Z: z}}
+	}
+	return points
+}
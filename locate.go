@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// tieEps bounds the difference of dot products under which two sites are treated as
+	// possibly equidistant from a query point and resolved by the exact predicate.
+	tieEps = 1e-12
+)
+
+var (
+	northPole = s2.PointFromCoords(0, 0, 1)
+	southPole = s2.PointFromCoords(0, 0, -1)
+)
+
+// CellContainingPoint returns the cell whose site is nearest to p.
+// Points equidistant from several sites, such as points on a cell boundary, are assigned
+// deterministically using the symbolic tie-breaking of s2.CompareDistances.
+func (d *Diagram) CellContainingPoint(p s2.Point) Cell {
+	return Cell{idx: d.locate(p, 0), d: d}
+}
+
+// NearestSite returns the index of the site nearest to p, whose cell contains p. It is
+// equivalent to CellContainingPoint(p).SiteIndex(), walking the neighbor graph from cell 0
+// rather than scanning all sites; use NearestSiteFrom to start from a closer cell. A diagram
+// built WithoutNeighbors is scanned instead.
+func (d *Diagram) NearestSite(p s2.Point) int {
+	return d.locate(p, 0)
+}
+
+// PolarCells returns the indices of the cells containing the north and south poles.
+func (d *Diagram) PolarCells() (north, south int) {
+	return d.locate(northPole, 0), d.locate(southPole, 0)
+}
+
+// CellByRay returns the cell hit by the ray from origin along dir at its nearest intersection
+// with the unit sphere in front of the origin. It returns false if the ray misses the sphere or
+// dir is the zero vector.
+func (d *Diagram) CellByRay(origin, dir r3.Vector) (Cell, bool) {
+	a := dir.Norm2()
+	b := 2 * origin.Dot(dir)
+	c := origin.Norm2() - 1
+	disc := b*b - 4*a*c
+	if a == 0 || disc < 0 {
+		return Cell{}, false
+	}
+
+	sq := math.Sqrt(disc)
+	t := (-b - sq) / (2 * a)
+	if t < 0 {
+		t = (-b + sq) / (2 * a)
+	}
+	if t < 0 {
+		return Cell{}, false
+	}
+
+	hit := s2.Point{Vector: origin.Add(dir.Mul(t)).Normalize()}
+	return d.CellContainingPoint(hit), true
+}
+
+// locateAll returns the index of the site nearest to each point. The points are split into
+// contiguous chunks located in parallel, each walk starting from the result for the previous
+// point of its chunk, so spatially coherent point orders locate fastest.
+func (d *Diagram) locateAll(points s2.PointVector) []int {
+	out := make([]int, len(points))
+	workers := min(runtime.GOMAXPROCS(0), len(points))
+	var wg sync.WaitGroup
+	for w := range workers {
+		lo, hi := w*len(points)/workers, (w+1)*len(points)/workers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hint := 0
+			for i := lo; i < hi; i++ {
+				out[i] = d.locate(points[i], hint)
+				hint = out[i]
+			}
+		}()
+	}
+	wg.Wait()
+	return out
+}
+
+// NearestSiteFrom returns the index of the site nearest to p like NearestSite, walking the
+// neighbor graph from the cell start instead of cell 0.
+// It returns an error wrapping ErrOutOfRange if start is not a cell index, or wrapping
+// ErrCorruptNeighbors if a neighbor ring lists an index out of range or the walk does not
+// converge within NumCells steps, in which case NearestSite falls back to scanning all sites.
+func (d *Diagram) NearestSiteFrom(p s2.Point, start int) (int, error) {
+	if start < 0 || start >= d.NumCells() {
+		return -1, fmt.Errorf("NearestSiteFrom: start %d %w [0 %d)", start, ErrOutOfRange,
+			d.NumCells())
+	}
+	i, err := d.walk(p, start)
+	if err != nil {
+		return -1, fmt.Errorf("NearestSiteFrom: %w", err)
+	}
+	return i, nil
+}
+
+// locate returns the index of the site nearest to p by walking the neighbor graph from start.
+// A walk failing with ErrCorruptNeighbors falls back to scanning all sites.
+func (d *Diagram) locate(p s2.Point, start int) int {
+	i, err := d.walk(p, start)
+	if err != nil {
+		return d.scanSites(p)
+	}
+	return i
+}
+
+// walk returns the index of the site nearest to p by walking the neighbor graph from start.
+// Distances are compared with s2.CompareDistances, which falls back to exact arithmetic when
+// the floating-point comparison is uncertain and breaks exact ties symbolically, so every step
+// strictly approaches p under one total order and the walk cannot oscillate. A neighbor index
+// out of range, or a walk still moving after the number of cells, reports ErrCorruptNeighbors.
+// A diagram without neighbors is scanned instead.
+func (d *Diagram) walk(p s2.Point, start int) (int, error) {
+	if !d.hasNeighbors() {
+		return d.scanSites(p), nil
+	}
+	neighbors := func(i int) ([]int, error) {
+		ns := (Cell{idx: i, d: d}).NeighborIndices()
+		for _, n := range ns {
+			if n < 0 || n >= len(d.Sites) {
+				return nil, fmt.Errorf("%w: cell %d lists neighbor %d", ErrCorruptNeighbors, i, n)
+			}
+		}
+		return ns, nil
+	}
+	cur := start
+	converged := false
+	for range d.NumCells() {
+		ns, err := neighbors(cur)
+		if err != nil {
+			return -1, err
+		}
+		next := cur
+		for _, n := range ns {
+			if s2.CompareDistances(p, d.Sites[n], d.Sites[next]) < 0 {
+				next = n
+			}
+		}
+		if next == cur {
+			converged = true
+			break
+		}
+		cur = next
+	}
+	if !converged {
+		return -1, fmt.Errorf("%w: walk did not converge within %d steps", ErrCorruptNeighbors,
+			d.NumCells())
+	}
+
+	// Sites on a common empty circle around p need not be adjacent in the triangulation, so
+	// the walk may stop at any of them. Resolve the tie over all of them for determinism.
+	dot := p.Dot(d.Sites[cur].Vector)
+	best := cur
+	tied := []int{cur}
+	for i := 0; i < len(tied); i++ {
+		ns, err := neighbors(tied[i])
+		if err != nil {
+			return -1, err
+		}
+		for _, n := range ns {
+			if math.Abs(p.Dot(d.Sites[n].Vector)-dot) > tieEps || slices.Contains(tied, n) {
+				continue
+			}
+			tied = append(tied, n)
+			if s2.CompareDistances(p, d.Sites[n], d.Sites[best]) < 0 {
+				best = n
+			}
+		}
+	}
+	return best, nil
+}
+
+// scanSites returns the index of the site nearest to p by scanning all sites, with ties
+// broken like the walk in locate.
+func (d *Diagram) scanSites(p s2.Point) int {
+	best := 0
+	for i := range d.Sites {
+		if s2.CompareDistances(p, d.Sites[i], d.Sites[best]) < 0 {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// Locate
+
+func TestDiagram_CellContainingPoint(t *testing.T) {
+	vd := mustNewDiagram(t, 1000)
+	for i, p := range utils.GenerateRandomPoints(1000, 1) {
+		want := nearestSiteBruteForce(vd, p)
+		if got := vd.CellContainingPoint(p).SiteIndex(); got != want {
+			t.Errorf("vd.CellContainingPoint(points[%d]) = %d, want %d", i, got, want)
+		}
+	}
+	for i, s := range vd.Sites {
+		if got := vd.CellContainingPoint(s).SiteIndex(); got != i {
+			t.Errorf("vd.CellContainingPoint(vd.Sites[%d]) = %d, want %d", i, got, i)
+		}
+	}
+}
+
+func TestDiagram_PolarCells_SiteAtPole(t *testing.T) {
+	sites := append(utils.GenerateRandomPoints(50, 0), northPole, southPole)
+	vd, err := NewDiagram(sites)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	north, south := vd.PolarCells()
+	if north != 50 || south != 51 {
+		t.Errorf("vd.PolarCells() = %d, %d, want 50, 51", north, south)
+	}
+}
+
+func TestDiagram_PolarCells_PoleOnBoundary(t *testing.T) {
+	// Rings at ±30° latitude, offset by 22.5°: each pole is equidistant from the 8 sites of its
+	// hemisphere, so it lies on the Voronoi vertex where their cells meet.
+	var vertex s2.PointVector
+	for lng := 0.0; lng < 360; lng += 45 {
+		vertex = append(vertex,
+			s2.PointFromLatLng(s2.LatLngFromDegrees(30, lng)),
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-30, lng+22.5)))
+	}
+	// Pairs at ±60° latitude on opposite meridians, mirrored about the equator, and an
+	// equatorial ring farther away: each pole is equidistant from only the 2 sites of its pair,
+	// so it lies inside the Voronoi edge between their cells.
+	edge := s2.PointVector{
+		s2.PointFromLatLng(s2.LatLngFromDegrees(60, 0)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(60, 180)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(-60, 0)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(-60, 180)),
+	}
+	for lng := 45.0; lng < 360; lng += 90 {
+		edge = append(edge, s2.PointFromLatLng(s2.LatLngFromDegrees(0, lng)))
+	}
+
+	tests := []struct {
+		name    string
+		sites   s2.PointVector
+		numTied int
+	}{
+		{"vertex", vertex, 8},
+		{"edge", edge, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vd, err := NewDiagram(tt.sites)
+			if err != nil {
+				t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+			}
+			north, south := vd.PolarCells()
+			for _, pc := range []struct {
+				name string
+				pole s2.Point
+				cell int
+			}{{"north", northPole, north}, {"south", southPole, south}} {
+				nearest := vd.Sites[vd.scanSites(pc.pole)].Distance(pc.pole)
+				var tied []int
+				for i, p := range vd.Sites {
+					if p.Distance(pc.pole)-nearest < 1e-12 {
+						tied = append(tied, i)
+					}
+				}
+				if len(tied) != tt.numTied {
+					t.Fatalf("%s pole is nearest to sites %v, want %d of them", pc.name, tied,
+						tt.numTied)
+				}
+				if !slices.Contains(tied, pc.cell) {
+					t.Errorf("%s polar cell = %d, want one of %v", pc.name, pc.cell, tied)
+				}
+				// The walk may stop at any tied site, so the tie must be resolved the same way
+				// from every start, and as by a scan of all sites.
+				if want := vd.scanSites(pc.pole); pc.cell != want {
+					t.Errorf("%s polar cell = %d, want %d", pc.name, pc.cell, want)
+				}
+				for start := range vd.NumCells() {
+					if got := vd.locate(pc.pole, start); got != pc.cell {
+						t.Errorf("vd.locate(%s pole, %d) = %d, want %d", pc.name, start, got,
+							pc.cell)
+					}
+				}
+			}
+			if lat := s2.LatLngFromPoint(vd.Sites[north]).Lat; lat <= 0 {
+				t.Errorf("north cell site latitude = %v, want > 0", lat)
+			}
+			if lat := s2.LatLngFromPoint(vd.Sites[south]).Lat; lat >= 0 {
+				t.Errorf("south cell site latitude = %v, want < 0", lat)
+			}
+		})
+	}
+}
+
+func TestDiagram_Locate_Bisectors(t *testing.T) {
+	// The vertices of an octahedron and a cube: permuting the coordinates of a query point maps
+	// sites to sites, so points with repeated coordinates lie exactly on bisectors.
+	var sites s2.PointVector
+	for _, v := range []float64{-1, 1} {
+		sites = append(sites, s2.PointFromCoords(v, 0, 0), s2.PointFromCoords(0, v, 0),
+			s2.PointFromCoords(0, 0, v))
+	}
+	for _, x := range []float64{-1, 1} {
+		for _, y := range []float64{-1, 1} {
+			for _, z := range []float64{-1, 1} {
+				sites = append(sites, s2.PointFromCoords(x, y, z))
+			}
+		}
+	}
+	vd, err := NewDiagram(sites)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	var queries s2.PointVector
+	for _, c := range [][3]float64{
+		{1, 1, 0}, {1, 1, 1}, {2, 1, 1}, {1, 2, 2}, {3, 3, 1}, {1, 1, 1e-300}, {1, -1, 0},
+	} {
+		for _, sign := range []float64{-1, 1} {
+			queries = append(queries, s2.PointFromCoords(sign*c[0], c[1], c[2]),
+				s2.PointFromCoords(c[2], sign*c[0], c[1]))
+		}
+	}
+	for _, p := range queries {
+		want := vd.scanSites(p)
+		for start := range vd.NumCells() {
+			if got := vd.locate(p, start); got != want {
+				t.Errorf("vd.locate(%v, %d) = %d, want %d", p, start, got, want)
+			}
+		}
+	}
+}
+
+func TestDiagram_NearestSite(t *testing.T) {
+	vd := mustNewDiagram(t, 300)
+	for i, p := range utils.GenerateRandomPoints(500, 1) {
+		got := vd.NearestSite(p)
+		if want := vd.scanSites(p); got != want {
+			t.Errorf("vd.NearestSite(points[%d]) = %d, want %d", i, got, want)
+		}
+		if !(Cell{idx: got, d: vd}).Loop().ContainsPoint(p) {
+			t.Errorf("cell %d = vd.NearestSite(points[%d]) does not contain the point", got, i)
+		}
+	}
+}
+
+func TestDiagram_NearestSiteFrom(t *testing.T) {
+	vd := mustNewDiagram(t, 300)
+	for i, p := range utils.GenerateRandomPoints(100, 1) {
+		for _, start := range []int{0, i, vd.NumCells() - 1} {
+			got, err := vd.NearestSiteFrom(p, start)
+			if err != nil {
+				t.Fatalf("vd.NearestSiteFrom(points[%d], %d) error = %v, want nil", i, start, err)
+			}
+			if want := vd.scanSites(p); got != want {
+				t.Errorf("vd.NearestSiteFrom(points[%d], %d) = %d, want %d", i, start, got, want)
+			}
+		}
+	}
+	for _, start := range []int{-1, vd.NumCells()} {
+		if _, err := vd.NearestSiteFrom(northPole, start); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("vd.NearestSiteFrom(north, %d) error = %v, want %v", start, err,
+				ErrOutOfRange)
+		}
+	}
+}
+
+func TestDiagram_NearestSiteFrom_CorruptNeighbors(t *testing.T) {
+	vd := mustNewDiagram(t, 300)
+	p := vd.Sites[100]
+	vd.CellNeighbors[vd.CellOffsets[0]] = vd.NumCells()
+	if _, err := vd.NearestSiteFrom(p, 0); !errors.Is(err, ErrCorruptNeighbors) {
+		t.Errorf("vd.NearestSiteFrom(p, 0) error = %v, want %v", err, ErrCorruptNeighbors)
+	}
+	if got := vd.NearestSite(p); got != 100 {
+		t.Errorf("vd.NearestSite(p) = %d, want 100", got)
+	}
+}
+
+func TestDiagram_Locate_NearBisectors(t *testing.T) {
+	vd := mustNewDiagram(t, 300)
+	for i := range vd.NumCells() {
+		for _, n := range (Cell{idx: i, d: vd}).NeighborIndices() {
+			p := s2.Point{Vector: vd.Sites[i].Add(vd.Sites[n].Vector).Normalize()}
+			want := vd.scanSites(p)
+			for _, start := range []int{0, i, n, vd.NumCells() - 1} {
+				if got := vd.locate(p, start); got != want {
+					t.Errorf("vd.locate(mid(%d, %d), %d) = %d, want %d", i, n, start, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestDiagram_CellByRay(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	north, south := vd.PolarCells()
+	tests := []struct {
+		name   string
+		origin r3.Vector
+		dir    r3.Vector
+		want   int
+		wantOk bool
+	}{
+		{"from above", r3.Vector{Z: 5}, r3.Vector{Z: -1}, north, true},
+		{"from inside", r3.Vector{}, r3.Vector{Z: -3}, south, true},
+		{"from surface outward", r3.Vector{Z: 1}, r3.Vector{Z: 1}, north, true},
+		{"pointing away", r3.Vector{Z: 5}, r3.Vector{Z: 1}, 0, false},
+		{"miss", r3.Vector{X: 2, Z: 5}, r3.Vector{Z: -1}, 0, false},
+		{"zero dir", r3.Vector{Z: 5}, r3.Vector{}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ok := vd.CellByRay(tt.origin, tt.dir)
+			if ok != tt.wantOk {
+				t.Fatalf("vd.CellByRay(%v, %v) ok = %v, want %v", tt.origin, tt.dir, ok, tt.wantOk)
+			}
+			if ok && c.SiteIndex() != tt.want {
+				t.Errorf("vd.CellByRay(%v, %v) = %d, want %d", tt.origin, tt.dir, c.SiteIndex(),
+					tt.want)
+			}
+		})
+	}
+
+	for i, p := range utils.GenerateRandomPoints(100, 1) {
+		origin := p.Mul(3)
+		c, ok := vd.CellByRay(origin, p.Mul(-1))
+		if want := vd.CellContainingPoint(p).SiteIndex(); !ok || c.SiteIndex() != want {
+			t.Errorf("vd.CellByRay(points[%d]) = %d, %v, want %d, true", i, c.SiteIndex(), ok, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/2dChan/s2voronoi/internal/buildclock"
+	"github.com/2dChan/s2voronoi/s2delaunay"
+)
+
+// BuildMetrics reports where a diagram build spent its time and memory.
+type BuildMetrics struct {
+	// Triangulation holds the metrics of the underlying Delaunay triangulation. It is zero for
+	// diagrams created from an existing triangulation.
+	Triangulation s2delaunay.BuildMetrics
+
+	// Sites is the number of input sites.
+	Sites int
+	// Vertices is the number of Voronoi vertices built.
+	Vertices int
+
+	// Copy is the time spent copying or aliasing the triangulation arrays.
+	Copy time.Duration
+	// Circumcenters is the time spent computing the Voronoi vertices.
+	Circumcenters time.Duration
+	// NeighborFill is the time spent filling the cell neighbor rings.
+	NeighborFill time.Duration
+	// Total is the time spent in the whole build, including the triangulation.
+	Total time.Duration
+
+	// Allocs is the number of heap allocations made during the build.
+	Allocs uint64
+	// AllocBytes is the number of heap bytes allocated during the build.
+	AllocBytes uint64
+}
+
+// WithMetrics fills m with the phase durations, allocation counts and sizes of the build, and
+// again on every Rebuild. Allocations are measured process-wide with runtime.ReadMemStats, so
+// they are best-effort when other goroutines allocate concurrently.
+func WithMetrics(m *BuildMetrics) DiagramOption {
+	return func(o *DiagramOptions) error {
+		if m == nil {
+			return &OptionError{Name: "WithMetrics",
+				Err: fmt.Errorf("%w: m must not be nil", ErrInvalidOption)}
+		}
+		o.Metrics = m
+		return nil
+	}
+}
+
+// startMetrics resets the configured metrics for a build from numSites sites and returns the
+// clock measuring it, or nil if no metrics are configured.
+func (d *Diagram) startMetrics(numSites int) *buildclock.Clock {
+	m := d.opts.Metrics
+	if m == nil {
+		return nil
+	}
+	*m = BuildMetrics{Sites: numSites}
+	return buildclock.Start()
+}
+
+// finishMetrics records the totals measured by clock, if it is not nil.
+func (d *Diagram) finishMetrics(clock *buildclock.Clock) {
+	if clock == nil {
+		return
+	}
+	m := d.opts.Metrics
+	m.Total, m.Allocs, m.AllocBytes = clock.Finish()
+}
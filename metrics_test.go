@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/2dChan/s2voronoi/utils"
+)
+
+// Metrics
+
+func TestWithMetrics(t *testing.T) {
+	points := utils.GenerateRandomPoints(10000, 0)
+	var m BuildMetrics
+	vd, err := NewDiagram(points, WithMetrics(&m))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	if m.Sites != vd.NumCells() || m.Vertices != len(vd.Vertices) {
+		t.Errorf("m.Sites, m.Vertices = %d, %d, want %d, %d", m.Sites, m.Vertices, vd.NumCells(),
+			len(vd.Vertices))
+	}
+	tm := m.Triangulation
+	phases := []struct {
+		name string
+		d    time.Duration
+	}{
+		{"Triangulation.Hull", tm.Hull},
+		{"Triangulation.Orientation", tm.Orientation},
+		{"Triangulation.IncidenceBuild", tm.IncidenceBuild},
+		{"Triangulation.IncidenceSort", tm.IncidenceSort},
+		{"Copy", m.Copy},
+		{"Circumcenters", m.Circumcenters},
+		{"NeighborFill", m.NeighborFill},
+	}
+	var sum time.Duration
+	for _, p := range phases {
+		if p.d <= 0 {
+			t.Errorf("m.%s = %v, want positive", p.name, p.d)
+		}
+		sum += p.d
+	}
+	if sum > m.Total || sum < m.Total/2 {
+		t.Errorf("phase sum = %v, want in [%v, %v]", sum, m.Total/2, m.Total)
+	}
+	if tm.Total > m.Total {
+		t.Errorf("m.Triangulation.Total = %v, want <= m.Total = %v", tm.Total, m.Total)
+	}
+	if m.Allocs < tm.Allocs || m.AllocBytes < tm.AllocBytes || tm.Allocs == 0 {
+		t.Errorf("m.Allocs = %d, m.Triangulation.Allocs = %d, want 0 < inner <= outer",
+			m.Allocs, tm.Allocs)
+	}
+
+	if err := vd.Rebuild(utils.GenerateRandomPoints(100, 1)); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	if m.Sites != 100 || m.Triangulation.Vertices != 100 {
+		t.Errorf("m.Sites, m.Triangulation.Vertices = %d, %d after Rebuild, want 100, 100",
+			m.Sites, m.Triangulation.Vertices)
+	}
+
+	if _, err := NewDiagram(points, WithMetrics(nil)); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("NewDiagram(WithMetrics(nil)) error = %v, want ErrInvalidOption", err)
+	}
+}
+
+func TestWithMetrics_FromTriangulation(t *testing.T) {
+	dt, err := s2delaunay.NewTriangulation(utils.GenerateRandomPoints(1000, 0))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	var m BuildMetrics
+	if _, err := NewDiagramFromTriangulation(dt, WithMetrics(&m)); err != nil {
+		t.Fatalf("NewDiagramFromTriangulation(...) error = %v, want nil", err)
+	}
+	if m.Triangulation != (s2delaunay.BuildMetrics{}) {
+		t.Errorf("m.Triangulation = %+v, want zero", m.Triangulation)
+	}
+	if m.Sites != 1000 || m.Circumcenters <= 0 || m.Total <= 0 {
+		t.Errorf("m = %+v, want Sites 1000 and positive Circumcenters and Total", m)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"slices"
+)
+
+// WithoutNeighbors makes construction and Rebuild skip filling CellNeighbors and leave it nil,
+// saving an array as large as CellVertices and a pass over the triangulation, for workloads
+// such as rendering that only need the rings. Until BuildNeighbors is called:
+//   - point location, as in NearestSite, CellContainingPoint and Aggregate, scans all sites
+//     instead of walking the neighbor graph;
+//   - Cell.Neighbor, Cell.ExtentToward, UpdateSitePositions, PartitionByCount, AssignBalanced,
+//     Dissolve, CompareIterations, DumpInternals and MarshalText return an error wrapping
+//     ErrNoNeighbors;
+//   - SitesWithinAngle, Shard, CellsOnGreatCircle and Overlay return nil, and CastRay false;
+//   - Cell.NeighborIndices, SeparatingPlanes and NeighborDistances panic with ErrNoNeighbors;
+//   - Check skips the rings and symmetry checks, and the binary encoding keeps the neighbors
+//     absent.
+//
+// NumNeighbors keeps working. FromRings ignores the option.
+func WithoutNeighbors() DiagramOption {
+	return func(o *DiagramOptions) error {
+		o.WithoutNeighbors = true
+		return nil
+	}
+}
+
+// hasNeighbors reports whether CellNeighbors is filled, which is false for a diagram built
+// WithoutNeighbors until BuildNeighbors is called.
+func (d *Diagram) hasNeighbors() bool {
+	return len(d.CellNeighbors) > 0 || len(d.CellVertices) == 0
+}
+
+// BuildNeighbors fills CellNeighbors of a diagram built WithoutNeighbors by recomputing them
+// from the rings: neighbor k of a cell is the other cell sharing its ring vertices k and k+1,
+// as every vertex is shared by the three cells of its Delaunay triangle. It does nothing if the
+// neighbors are already present. Later rebuilds leave them nil again.
+// It must not be called concurrently with other methods of the diagram.
+// It returns an error if a vertex is not shared by exactly three cells or a ring edge is not
+// shared by two of them.
+func (d *Diagram) BuildNeighbors() error {
+	if d.hasNeighbors() {
+		return nil
+	}
+	cells := make([][3]int, len(d.Vertices))
+	counts := make([]int, len(d.Vertices))
+	for i := range d.NumCells() {
+		for _, v := range (Cell{idx: i, d: d}).VertexIndices() {
+			if counts[v] == 3 {
+				return fmt.Errorf("BuildNeighbors: vertex %d is shared by more than 3 cells", v)
+			}
+			cells[v][counts[v]] = i
+			counts[v]++
+		}
+	}
+
+	neighbors := make([]int, len(d.CellVertices))
+	for i := range d.NumCells() {
+		offset := d.CellOffsets[i]
+		ring := Cell{idx: i, d: d}.VertexIndices()
+		for k, v := range ring {
+			w := ring[(k+1)%len(ring)]
+			neighbors[offset+k] = -1
+			for _, c := range cells[v][:counts[v]] {
+				if c != i && slices.Contains(cells[w][:counts[w]], c) {
+					neighbors[offset+k] = c
+				}
+			}
+			if neighbors[offset+k] < 0 {
+				return fmt.Errorf("BuildNeighbors: cell %d: edge from vertex %d to %d is not "+
+					"shared with another cell", i, v, w)
+			}
+		}
+	}
+	d.CellNeighbors = neighbors
+	return nil
+}
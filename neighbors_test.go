@@ -0,0 +1,285 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Neighbors
+
+func TestWithoutNeighbors(t *testing.T) {
+	points := utils.GenerateRandomPoints(500, 0)
+	want, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	vd, err := NewDiagram(points, WithoutNeighbors())
+	if err != nil {
+		t.Fatalf("NewDiagram(..., WithoutNeighbors()) error = %v, want nil", err)
+	}
+	if vd.CellNeighbors != nil {
+		t.Fatalf("vd.CellNeighbors has %d entries, want nil", len(vd.CellNeighbors))
+	}
+	if diff := cmp.Diff(want.CellVertices, vd.CellVertices); diff != "" {
+		t.Errorf("vd.CellVertices mismatch (-want +got):\n%s", diff)
+	}
+
+	c := Cell{idx: 3, d: vd}
+	if got, wantN := c.NumNeighbors(), (Cell{idx: 3, d: want}).NumNeighbors(); got != wantN {
+		t.Errorf("c.NumNeighbors() = %d, want %d", got, wantN)
+	}
+	if _, err := c.Neighbor(0); !errors.Is(err, ErrNoNeighbors) {
+		t.Errorf("c.Neighbor(0) error = %v, want %v", err, ErrNoNeighbors)
+	}
+	func() {
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, ErrNoNeighbors) {
+				t.Errorf("c.NeighborIndices() panic = %v, want %v", err, ErrNoNeighbors)
+			}
+		}()
+		c.NeighborIndices()
+	}()
+
+	if err := vd.BuildNeighbors(); err != nil {
+		t.Fatalf("vd.BuildNeighbors() error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want.CellNeighbors, vd.CellNeighbors); diff != "" {
+		t.Errorf("vd.CellNeighbors after BuildNeighbors mismatch (-want +got):\n%s", diff)
+	}
+	if r := Check(vd); !r.OK() {
+		t.Errorf("Check(vd) after BuildNeighbors failed:\n%s", r)
+	}
+
+	if err := vd.Rebuild(utils.GenerateRandomPoints(100, 1)); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	if vd.CellNeighbors != nil {
+		t.Errorf("vd.CellNeighbors has %d entries after Rebuild, want nil", len(vd.CellNeighbors))
+	}
+}
+
+func TestBuildNeighbors_Invalid(t *testing.T) {
+	vd, err := NewDiagram(utils.GenerateRandomPoints(50, 0), WithoutNeighbors())
+	if err != nil {
+		t.Fatalf("NewDiagram(..., WithoutNeighbors()) error = %v, want nil", err)
+	}
+	// Point a ring entry at a vertex the cell does not share with its neighbors.
+	ring := Cell{idx: 0, d: vd}.VertexIndices()
+	ring[0] = (Cell{idx: vd.NumCells() - 1, d: vd}).VertexIndices()[0]
+	if err := vd.BuildNeighbors(); err == nil {
+		t.Errorf("vd.BuildNeighbors() error = nil, want non-nil")
+	}
+	if vd.CellNeighbors != nil {
+		t.Errorf("vd.CellNeighbors set after failed BuildNeighbors, want nil")
+	}
+}
+
+// mustNewDiagramPair returns diagrams of the same random sites built with and without neighbors.
+func mustNewDiagramPair(t *testing.T, n int) (full, bare *Diagram) {
+	t.Helper()
+	points := utils.GenerateRandomPoints(n, 0)
+	full, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	bare, err = NewDiagram(points, WithoutNeighbors())
+	if err != nil {
+		t.Fatalf("NewDiagram(..., WithoutNeighbors()) error = %v, want nil", err)
+	}
+	return full, bare
+}
+
+func TestWithoutNeighbors_Locate(t *testing.T) {
+	full, bare := mustNewDiagramPair(t, 200)
+	points := utils.GenerateRandomPoints(100, 1)
+	for _, p := range points {
+		if got, want := bare.NearestSite(p), full.NearestSite(p); got != want {
+			t.Errorf("bare.NearestSite(%v) = %d, want %d", p, got, want)
+		}
+		if got, want := bare.CellContainingPoint(p).SiteIndex(),
+			full.CellContainingPoint(p).SiteIndex(); got != want {
+			t.Errorf("bare.CellContainingPoint(%v) = %d, want %d", p, got, want)
+		}
+		if got, ok := bare.CellByRay(r3.Vector{}, p.Vector); !ok ||
+			got.SiteIndex() != full.NearestSite(p) {
+			t.Errorf("bare.CellByRay(0, %v) = %d, %v, want %d, true", p, got.SiteIndex(), ok,
+				full.NearestSite(p))
+		}
+	}
+	gotN, gotS := bare.PolarCells()
+	wantN, wantS := full.PolarCells()
+	if gotN != wantN || gotS != wantS {
+		t.Errorf("bare.PolarCells() = %d, %d, want %d, %d", gotN, gotS, wantN, wantS)
+	}
+
+	values := make([]float64, len(points))
+	got, err := bare.Aggregate(points, values, AggregateCount)
+	if err != nil {
+		t.Fatalf("bare.Aggregate(...) error = %v, want nil", err)
+	}
+	want, _ := full.Aggregate(points, values, AggregateCount)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("bare.Aggregate(...) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWithoutNeighbors_Errors(t *testing.T) {
+	full, bare := mustNewDiagramPair(t, 200)
+	points := utils.GenerateRandomPoints(100, 1)
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"UpdateSitePositions", func() error {
+			_, err := bare.UpdateSitePositions(bare.Sites)
+			return err
+		}},
+		{"PartitionByCount", func() error {
+			_, err := bare.PartitionByCount(points, 4)
+			return err
+		}},
+		{"AssignBalanced", func() error {
+			capacities := make([]int, bare.NumCells())
+			for i := range capacities {
+				capacities[i] = len(points)
+			}
+			_, err := bare.AssignBalanced(points, capacities)
+			return err
+		}},
+		{"Dissolve", func() error {
+			_, err := bare.Dissolve(func(int) string { return "" })
+			return err
+		}},
+		{"CompareIterations", func() error {
+			_, err := CompareIterations(full, bare, 0)
+			return err
+		}},
+		{"DumpInternals", func() error { return bare.DumpInternals(io.Discard) }},
+		{"MarshalText", func() error {
+			_, err := bare.MarshalText()
+			return err
+		}},
+		{"ExtentToward", func() error {
+			_, err := Cell{idx: 0, d: bare}.ExtentToward(s2.Ortho(bare.Sites[0]).Vector)
+			return err
+		}},
+		{"DensityEstimate", func() error {
+			_, _, err := DensityEstimate(points, WithSmoothingRings(1),
+				WithDensityDiagramOptions(WithoutNeighbors()))
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrNoNeighbors) {
+				t.Errorf("%s error = %v, want %v", tt.name, err, ErrNoNeighbors)
+			}
+		})
+	}
+}
+
+func TestWithoutNeighbors_Empty(t *testing.T) {
+	full, bare := mustNewDiagramPair(t, 200)
+	if sites, distances := bare.SitesWithinAngle(0, math.Pi); sites != nil || distances != nil {
+		t.Errorf("bare.SitesWithinAngle(0, π) = %v, %v, want nil, nil", sites, distances)
+	}
+	if got := bare.Shard(4); got != nil {
+		t.Errorf("bare.Shard(4) = %v, want nil", got)
+	}
+	if got := bare.CellsOnGreatCircle(s2.PointFromCoords(0, 0, 1)); got != nil {
+		t.Errorf("bare.CellsOnGreatCircle(z) = %v, want nil", got)
+	}
+	if got := Overlay(full, bare); got != nil {
+		t.Errorf("Overlay(full, bare) has %d pairs, want nil", len(got))
+	}
+	if _, _, _, ok := bare.CastRay(bare.Sites[0], s2.Ortho(bare.Sites[0]), math.Pi); ok {
+		t.Errorf("bare.CastRay(...) ok = true, want false")
+	}
+	for name, call := range map[string]func(){
+		"SeparatingPlanes":  func() { Cell{idx: 0, d: bare}.SeparatingPlanes() },
+		"NeighborDistances": func() { Cell{idx: 0, d: bare}.NeighborDistances() },
+	} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, ErrNoNeighbors) {
+					t.Errorf("%s panic = %v, want %v", name, err, ErrNoNeighbors)
+				}
+			}()
+			call()
+		}()
+	}
+}
+
+func TestWithoutNeighbors_CheckAndEncode(t *testing.T) {
+	_, bare := mustNewDiagramPair(t, 200)
+	r := Check(bare)
+	if !r.OK() || !checkResult(r, "rings").Skipped || !checkResult(r, "symmetry").Skipped {
+		t.Errorf("Check(bare) = %s, want OK with rings and symmetry skipped", r)
+	}
+
+	data, err := bare.MarshalBinary()
+	if err != nil {
+		t.Fatalf("bare.MarshalBinary() error = %v, want nil", err)
+	}
+	var decoded Diagram
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary(MarshalBinary()) error = %v, want nil", err)
+	}
+	if decoded.CellNeighbors != nil {
+		t.Errorf("decoded.CellNeighbors has %d entries, want nil", len(decoded.CellNeighbors))
+	}
+	if diff := cmp.Diff(bare.CellVertices, decoded.CellVertices); diff != "" {
+		t.Errorf("decoded.CellVertices mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func BenchmarkNewDiagram_WithoutNeighbors(b *testing.B) {
+	sizes := []int{1e+4, 1e+5}
+	for _, pointsCnt := range sizes {
+		b.Run(fmt.Sprintf("N%d", pointsCnt), func(b *testing.B) {
+			points := utils.GenerateRandomPoints(pointsCnt, 0)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for b.Loop() {
+				_, err := NewDiagram(points, WithoutNeighbors())
+				if err != nil {
+					b.Fatalf("NewDiagram(...) error = %v, want nil", err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDiagram_BuildNeighbors(b *testing.B) {
+	sizes := []int{1e+4, 1e+5}
+	for _, pointsCnt := range sizes {
+		b.Run(fmt.Sprintf("N%d", pointsCnt), func(b *testing.B) {
+			vd, err := NewDiagram(utils.GenerateRandomPoints(pointsCnt, 0), WithoutNeighbors())
+			if err != nil {
+				b.Fatalf("NewDiagram(...) error = %v, want nil", err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for b.Loop() {
+				vd.CellNeighbors = nil
+				if err := vd.BuildNeighbors(); err != nil {
+					b.Fatalf("vd.BuildNeighbors() error = %v, want nil", err)
+				}
+			}
+		})
+	}
+}
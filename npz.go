@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/2dChan/s2voronoi/internal/npy"
+)
+
+// WriteNPZ writes the diagram to w as a NumPy .npz archive containing sites.npy and
+// vertices.npy (float64, n×3) and the ring arrays cell_vertices.npy, cell_neighbors.npy and
+// cell_offsets.npy (int32). Load it with numpy.load.
+// It returns an error if an index does not fit in int32 or writing fails.
+func (d *Diagram) WriteNPZ(w io.Writer) error {
+	vertices, err := npy.Int32s(d.CellVertices)
+	if err != nil {
+		return fmt.Errorf("WriteNPZ: cell vertices: %w", err)
+	}
+	neighbors, err := npy.Int32s(d.CellNeighbors)
+	if err != nil {
+		return fmt.Errorf("WriteNPZ: cell neighbors: %w", err)
+	}
+	offsets, err := npy.Int32s(d.CellOffsets)
+	if err != nil {
+		return fmt.Errorf("WriteNPZ: cell offsets: %w", err)
+	}
+
+	return npy.WriteNPZ(w, []npy.Array{
+		{Name: "sites", Shape: []int{len(d.Sites), 3}, Float64: npy.Points(d.Sites)},
+		{Name: "vertices", Shape: []int{len(d.Vertices), 3}, Float64: npy.Points(d.Vertices)},
+		{Name: "cell_vertices", Shape: []int{len(vertices)}, Int32: vertices},
+		{Name: "cell_neighbors", Shape: []int{len(neighbors)}, Int32: neighbors},
+		{Name: "cell_offsets", Shape: []int{len(offsets)}, Int32: offsets},
+	})
+}
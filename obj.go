@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// WriteOBJ writes the cells of the diagram to w as a Wavefront OBJ mesh for viewers such as
+// Blender or MeshLab. It writes a "v x y z" line per Voronoi vertex, followed by one per cell
+// with its Centroid, and fan-triangulates every cell into "f" faces joining the centroid to
+// each edge of the ring, with 1-based indices. The ring of VertexIndices keeps the cell on the
+// right of each edge, as described at Loop, so the faces take the edges in reverse to have
+// their normals point out of the sphere. Coincident vertices are written separately; use
+// CompactVertices for a mesh that shares them.
+func (d *Diagram) WriteOBJ(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range d.Vertices {
+		fmt.Fprintf(bw, "v %v %v %v\n", v.X, v.Y, v.Z)
+	}
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}.Centroid()
+		fmt.Fprintf(bw, "v %v %v %v\n", c.X, c.Y, c.Z)
+	}
+	for i := range d.NumCells() {
+		center := len(d.Vertices) + i + 1
+		ring := Cell{idx: i, d: d}.VertexIndices()
+		for k, v := range ring {
+			fmt.Fprintf(bw, "f %d %d %d\n", center, ring[(k+1)%len(ring)]+1, v+1)
+		}
+	}
+	return bw.Flush()
+}
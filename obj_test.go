@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/internal/objtest"
+)
+
+// OBJ
+
+func TestDiagram_WriteOBJ(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	var buf bytes.Buffer
+	if err := vd.WriteOBJ(&buf); err != nil {
+		t.Fatalf("vd.WriteOBJ(...) error = %v, want nil", err)
+	}
+
+	vertices, faces := objtest.Parse(t, &buf)
+	if want := len(vd.Vertices) + vd.NumCells(); len(vertices) != want {
+		t.Fatalf("len(vertices) = %d, want %d", len(vertices), want)
+	}
+	if len(faces) != len(vd.CellVertices) {
+		t.Fatalf("len(faces) = %d, want %d", len(faces), len(vd.CellVertices))
+	}
+	for i, f := range faces {
+		for _, idx := range f {
+			if idx < 1 || idx > len(vertices) {
+				t.Fatalf("face %d index %d out of range [1 %d]", i, idx, len(vertices))
+			}
+		}
+		a, b, c := vertices[f[0]-1], vertices[f[1]-1], vertices[f[2]-1]
+		if n := b.Sub(a).Cross(c.Sub(a)); n.Dot(a) <= 0 {
+			t.Errorf("face %d normal %v points into the sphere", i, n)
+		}
+	}
+}
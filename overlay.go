@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"slices"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// overlayAreaEps is the intersection area in steradians at or below which two cells are
+	// treated as only touching along their boundaries.
+	overlayAreaEps = 1e-12
+)
+
+// OverlayPair is a pair of intersecting cells from two diagrams.
+type OverlayPair struct {
+	// A is the index of the cell in the first diagram.
+	A int
+	// B is the index of the cell in the second diagram.
+	B int
+	// Area is the area of the intersection of the two cells in steradians.
+	Area float64
+}
+
+// Overlay returns the pairs of cells of a and b whose interiors intersect, with the area of
+// each intersection, ordered by A and then by B. For each cell of a, the cell of b containing
+// its site is located and the neighbors of b are visited outward while they still intersect
+// it, so only nearby pairs are clipped. The cell of b is taken as the intersection of the
+// hemispheres bounded by its separating planes, which is exact for circumcentric diagrams.
+// The areas of the pairs of a cell sum to the area of that cell up to rounding. Truncation
+// by MaxRadius is ignored.
+// It returns nil if b was built WithoutNeighbors.
+func Overlay(a, b *Diagram) []OverlayPair {
+	if !b.hasNeighbors() {
+		return nil
+	}
+	var pairs []OverlayPair
+	visited := make([]int, b.NumCells())
+	hint := 0
+	for i := range a.NumCells() {
+		ca := Cell{idx: i, d: a}
+		ring := make([]s2.Point, ca.NumVertices())
+		for k, vIdx := range ca.VertexIndices() {
+			ring[k] = a.Vertices[vIdx]
+		}
+
+		start := b.locate(a.Sites[i], hint)
+		hint = start
+		stamp := i + 1
+		visited[start] = stamp
+		first := len(pairs)
+		queue := []int{start}
+		for len(queue) > 0 {
+			j := queue[0]
+			queue = queue[1:]
+			area := clippedArea(ring, Cell{idx: j, d: b}.SeparatingPlanes())
+			if area <= overlayAreaEps {
+				continue
+			}
+			pairs = append(pairs, OverlayPair{A: i, B: j, Area: area})
+			for _, n := range (Cell{idx: j, d: b}).NeighborIndices() {
+				if visited[n] != stamp {
+					visited[n] = stamp
+					queue = append(queue, n)
+				}
+			}
+		}
+		slices.SortFunc(pairs[first:], func(x, y OverlayPair) int { return x.B - y.B })
+	}
+	return pairs
+}
+
+// clippedArea returns the area of the ring clipped to the positive hemisphere of every plane.
+func clippedArea(ring []s2.Point, planes []r3.Vector) float64 {
+	for _, n := range planes {
+		ring = clipToHemisphere(ring, n)
+		if len(ring) < 3 {
+			return 0
+		}
+	}
+	area := 0.0
+	for k := 1; k+1 < len(ring); k++ {
+		area += s2delaunay.SphericalTriangleArea(ring[0], ring[k], ring[k+1])
+	}
+	return area
+}
+
+// clipToHemisphere returns the part of the ring on the positive side of the plane with normal
+// n, clipping one edge at a time as in Sutherland–Hodgman.
+func clipToHemisphere(ring []s2.Point, n r3.Vector) []s2.Point {
+	out := make([]s2.Point, 0, len(ring)+1)
+	for k, p := range ring {
+		q := ring[(k+1)%len(ring)]
+		sp, sq := p.Dot(n), q.Dot(n)
+		if sp >= 0 {
+			out = append(out, p)
+		}
+		if (sp < 0) != (sq < 0) {
+			// p|sq| + q|sp| lies on the plane and on the arc from p to q.
+			x := p.Mul(math.Abs(sq)).Add(q.Mul(math.Abs(sp)))
+			out = append(out, s2.Point{Vector: x.Normalize()})
+		}
+	}
+	return out
+}
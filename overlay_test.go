@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Overlay
+
+func TestOverlay_AreasSumToCells(t *testing.T) {
+	a := mustNewDiagram(t, 200)
+	b, err := NewDiagram(utils.GenerateRandomPoints(300, 1))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	pairs := Overlay(a, b)
+	sumA := make([]float64, a.NumCells())
+	sumB := make([]float64, b.NumCells())
+	for k, p := range pairs {
+		if p.Area <= 0 {
+			t.Errorf("pairs[%d].Area = %v, want positive", k, p.Area)
+		}
+		if k > 0 {
+			q := pairs[k-1]
+			if p.A < q.A || (p.A == q.A && p.B <= q.B) {
+				t.Fatalf("pairs[%d] = %+v follows %+v, want ordered by A then B", k, p, q)
+			}
+		}
+		sumA[p.A] += p.Area
+		sumB[p.B] += p.Area
+	}
+
+	const tol = 1e-10
+	for i, s := range sumA {
+		if want := a.cellArea(i); math.Abs(s-want) > tol {
+			t.Errorf("sum of areas for a-cell %d = %v, want %v", i, s, want)
+		}
+	}
+	for j, s := range sumB {
+		if want := b.cellArea(j); math.Abs(s-want) > tol {
+			t.Errorf("sum of areas for b-cell %d = %v, want %v", j, s, want)
+		}
+	}
+
+	if diff := cmp.Diff(pairs, Overlay(a, b)); diff != "" {
+		t.Errorf("Overlay(a, b) not deterministic (-first +second):\n%s", diff)
+	}
+}
+
+func TestOverlay_Self(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	pairs := Overlay(vd, vd)
+	if len(pairs) != vd.NumCells() {
+		t.Fatalf("len(Overlay(vd, vd)) = %d, want %d", len(pairs), vd.NumCells())
+	}
+	for i, p := range pairs {
+		if p.A != i || p.B != i {
+			t.Errorf("pairs[%d] = %+v, want A = B = %d", i, p, i)
+		}
+		if want := vd.cellArea(i); math.Abs(p.Area-want) > 1e-12 {
+			t.Errorf("pairs[%d].Area = %v, want %v", i, p.Area, want)
+		}
+	}
+}
+
+func TestClippedArea(t *testing.T) {
+	octant := []s2.Point{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(0, 0, 1),
+	}
+	tests := []struct {
+		name   string
+		planes []r3.Vector
+		want   float64
+	}{
+		{"none", nil, math.Pi / 2},
+		{"containing", []r3.Vector{{X: 1, Y: 1, Z: 1}}, math.Pi / 2},
+		{"halving", []r3.Vector{{X: 1, Y: -1, Z: 0}}, math.Pi / 4},
+		{"sixth", []r3.Vector{{X: 1, Y: -1, Z: 0}, {X: 0, Y: 1, Z: -1}}, math.Pi / 12},
+		{"disjoint", []r3.Vector{{X: -1, Y: -1, Z: -1}}, 0},
+		{"touching", []r3.Vector{{X: 0, Y: 0, Z: -1}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clippedArea(octant, tt.planes); math.Abs(got-tt.want) > 1e-15 {
+				t.Errorf("clippedArea(octant, %v) = %v, want %v", tt.planes, got, tt.want)
+			}
+		})
+	}
+}
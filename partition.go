@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// PartitionByCount assigns the points to their cells and partitions the cells into parts
+// connected groups holding roughly equal numbers of points, returning the cell indices of each
+// group in ascending order. Every cell belongs to exactly one group and the result is
+// deterministic.
+// The groups are first grown as in Shard from seeds among the cells holding points, weighting
+// cells by their point counts instead of their areas. Then groups, heaviest first, hand
+// boundary cells to lighter adjacent groups as long as that lowers the larger of the two
+// counts and the giving group stays connected. Cells are kept whole, so a single cell holding
+// many points limits the balance; CountImbalance measures it.
+// It returns an error if parts is not in [1, NumCells], or wrapping ErrNoNeighbors if the
+// diagram was built WithoutNeighbors.
+func (d *Diagram) PartitionByCount(points s2.PointVector, parts int) ([][]int, error) {
+	if !d.hasNeighbors() {
+		return nil, fmt.Errorf("PartitionByCount: %w", ErrNoNeighbors)
+	}
+	if parts < 1 || parts > d.NumCells() {
+		return nil, fmt.Errorf("PartitionByCount: parts %d %w [1 %d]", parts, ErrOutOfRange,
+			d.NumCells())
+	}
+	counts := d.pointCounts(points)
+	owner := d.growShards(parts, counts)
+	d.rebalance(owner, parts, counts)
+	return groupByOwner(owner, parts), nil
+}
+
+// CountImbalance returns the ratio of the largest number of points in a group to the mean
+// number of points per group, which is 1 for perfectly balanced groups. It returns 0 for no
+// groups or no points.
+func (d *Diagram) CountImbalance(points s2.PointVector, parts [][]int) float64 {
+	return imbalance(parts, d.pointCounts(points))
+}
+
+// pointCounts returns the number of points located in each cell.
+func (d *Diagram) pointCounts(points s2.PointVector) []float64 {
+	counts := make([]float64, d.NumCells())
+	for _, c := range d.locateAll(points) {
+		counts[c]++
+	}
+	return counts
+}
+
+// rebalance moves boundary cells between the k groups given by owner until no move is left,
+// trying the heaviest groups first. Each move of weight w from a group of load p to one of
+// load q < p - w strictly lowers the sum of squared loads, so the loop terminates.
+func (d *Diagram) rebalance(owner []int, k int, weights []float64) {
+	loads := make([]float64, k)
+	for i, g := range owner {
+		loads[g] += weights[i]
+	}
+	order := make([]int, k)
+	for {
+		for g := range order {
+			order[g] = g
+		}
+		slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(loads[b], loads[a]) })
+		moved := false
+		for _, g := range order {
+			if moved = d.moveFrom(owner, g, loads, weights); moved {
+				break
+			}
+		}
+		if !moved {
+			return
+		}
+	}
+}
+
+// moveFrom applies the best move of a cell out of group g that keeps it connected, and reports
+// whether there was one.
+func (d *Diagram) moveFrom(owner []int, g int, loads, weights []float64) bool {
+	for _, m := range d.rebalanceMoves(owner, g, loads, weights) {
+		if d.connectedWithout(owner, g, m.cell) {
+			owner[m.cell] = m.to
+			loads[g] -= weights[m.cell]
+			loads[m.to] += weights[m.cell]
+			return true
+		}
+	}
+	return false
+}
+
+// rebalanceMove hands cell to group to, after which the larger load of the two groups is peak.
+type rebalanceMove struct {
+	cell, to int
+	peak     float64
+}
+
+// rebalanceMoves returns the moves of a boundary cell of group g to an adjacent group that
+// lower the larger of the two loads, best first, ties going to the lowest cell and group.
+func (d *Diagram) rebalanceMoves(owner []int, g int, loads, weights []float64) []rebalanceMove {
+	var moves []rebalanceMove
+	for c, o := range owner {
+		w := weights[c]
+		if o != g || w == 0 {
+			continue
+		}
+		for _, nb := range (Cell{idx: c, d: d}).NeighborIndices() {
+			to := owner[nb]
+			if to != g && loads[to]+w < loads[g] {
+				moves = append(moves, rebalanceMove{cell: c, to: to,
+					peak: max(loads[g]-w, loads[to]+w)})
+			}
+		}
+	}
+	slices.SortStableFunc(moves, func(a, b rebalanceMove) int {
+		return cmp.Compare(a.peak, b.peak)
+	})
+	return moves
+}
+
+// connectedWithout reports whether the cells of group g other than without are connected, and
+// that there is at least one.
+func (d *Diagram) connectedWithout(owner []int, g, without int) bool {
+	start, size := -1, 0
+	for c, o := range owner {
+		if o == g && c != without {
+			if start < 0 {
+				start = c
+			}
+			size++
+		}
+	}
+	if start < 0 {
+		return false
+	}
+	seen := map[int]bool{start: true}
+	queue := []int{start}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		for _, nb := range (Cell{idx: c, d: d}).NeighborIndices() {
+			if owner[nb] == g && nb != without && !seen[nb] {
+				seen[nb] = true
+				queue = append(queue, nb)
+			}
+		}
+	}
+	return len(seen) == size
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Partition
+
+func TestDiagram_PartitionByCount(t *testing.T) {
+	tests := []struct {
+		cells, clusters, parts int
+	}{
+		{500, 3, 1},
+		{500, 3, 4},
+		{1000, 5, 8},
+		{2000, 10, 16},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("N%d_C%d_P%d", tt.cells, tt.clusters, tt.parts), func(t *testing.T) {
+			vd, err := NewDiagram(utils.GenerateRandomPoints(tt.cells, 0))
+			if err != nil {
+				t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+			}
+			points := clusteredPoints(20000, tt.clusters, int64(tt.parts))
+			parts, err := vd.PartitionByCount(points, tt.parts)
+			if err != nil {
+				t.Fatalf("vd.PartitionByCount(...) error = %v, want nil", err)
+			}
+			again, _ := vd.PartitionByCount(points, tt.parts)
+			if diff := cmp.Diff(parts, again); diff != "" {
+				t.Errorf("vd.PartitionByCount(...) is not deterministic (-first +second):\n%s",
+					diff)
+			}
+			if len(parts) != tt.parts {
+				t.Fatalf("len(vd.PartitionByCount(...)) = %d, want %d", len(parts), tt.parts)
+			}
+
+			owner := make([]int, vd.NumCells())
+			for i := range owner {
+				owner[i] = -1
+			}
+			for p, part := range parts {
+				for _, c := range part {
+					if owner[c] >= 0 {
+						t.Fatalf("cell %d assigned to parts %d and %d", c, owner[c], p)
+					}
+					owner[c] = p
+				}
+			}
+			if i := slices.Index(owner, -1); i >= 0 {
+				t.Fatalf("cell %d not assigned to any part", i)
+			}
+			for p, part := range parts {
+				if !shardConnected(vd, part, owner, p) {
+					t.Errorf("part %d is not connected", p)
+				}
+			}
+
+			if got := vd.CountImbalance(points, parts); got < 1 || got > 1.15 {
+				t.Errorf("vd.CountImbalance(...) = %v, want in [1 1.15]", got)
+			}
+		})
+	}
+}
+
+func TestDiagram_PartitionByCount_Invalid(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	points := utils.GenerateRandomPoints(100, 1)
+	for _, parts := range []int{0, -1, 11} {
+		if _, err := vd.PartitionByCount(points, parts); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("vd.PartitionByCount(points, %d) error = %v, want ErrOutOfRange", parts, err)
+		}
+	}
+	if got := vd.CountImbalance(nil, [][]int{{0, 1}, {2}}); got != 0 {
+		t.Errorf("vd.CountImbalance(nil, ...) = %v, want 0", got)
+	}
+
+	// With fewer occupied cells than parts, seeds fall back to all cells.
+	parts, err := vd.PartitionByCount(s2.PointVector{vd.Sites[3]}, 5)
+	if err != nil {
+		t.Fatalf("vd.PartitionByCount(one point, 5) error = %v, want nil", err)
+	}
+	if len(parts) != 5 {
+		t.Errorf("len(vd.PartitionByCount(one point, 5)) = %d, want 5", len(parts))
+	}
+}
+
+// clusteredPoints returns n points drawn around k random centers with an angular spread of
+// about 0.2 radians.
+func clusteredPoints(n, k int, seed int64) s2.PointVector {
+	centers := utils.GenerateRandomPoints(k, seed)
+	r := rand.New(rand.NewSource(seed))
+	points := make(s2.PointVector, n)
+	for i := range points {
+		c := centers[r.Intn(k)]
+		offset := s2.PointFromCoords(r.NormFloat64(), r.NormFloat64(), r.NormFloat64()).Mul(0.2)
+		points[i] = s2.Point{Vector: c.Add(offset).Normalize()}
+	}
+	return points
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/2dChan/s2voronoi/internal/wire"
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	progressiveMagic   = 0x50563253 // "S2VP"
+	progressiveVersion = 1
+
+	// progressiveHeaderSize is the size in bytes of the fixed header written by
+	// WriteProgressive.
+	progressiveHeaderSize = 20
+	// progressiveRatio is the factor by which the number of sites grows from one level to the
+	// next, doubling the resolution.
+	progressiveRatio = 4
+)
+
+// WriteProgressive encodes the diagram for progressive transmission in levels levels, coarse to
+// fine. The sites are reordered by farthest-point sampling starting from site 0, so that every
+// prefix covers the sphere evenly, and level k holds the first n_k of them, n_k growing by a
+// factor of 4 up to all sites in the last level with at least 4 sites in the first.
+// Each level is a length-prefixed block with the sites it adds and the changes to the sorted
+// Delaunay triangles of the previous level; the Voronoi vertices and neighbor rings are not
+// transmitted but recomputed by ReadProgressive. All levels together typically take less than
+// a third of the size of MarshalBinary of the diagram of each level.
+// Farthest-point sampling takes time quadratic in the number of sites.
+// It returns an error if levels is less than 1 or a level cannot be triangulated.
+func WriteProgressive(w io.Writer, d *Diagram, levels int) error {
+	if levels < 1 {
+		return fmt.Errorf("WriteProgressive: levels %d %w [1 inf)", levels, ErrOutOfRange)
+	}
+	order := farthestPointOrder(d.Sites)
+	sites := make(s2.PointVector, len(order))
+	for i, s := range order {
+		sites[i] = d.Sites[s]
+	}
+
+	var header wire.Writer
+	header.Uint32(progressiveMagic)
+	header.Uint32(progressiveVersion)
+	header.Uint32(uint32(d.Dual))
+	header.Uint32(uint32(len(sites)))
+	header.Uint32(uint32(levels))
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return fmt.Errorf("WriteProgressive: %w", err)
+	}
+
+	var prev []s2delaunay.Triangle
+	lo := 0
+	for k, hi := range progressiveSizes(len(sites), levels) {
+		dt, err := s2delaunay.NewTriangulation(sites[:hi], s2delaunay.WithEps(defaultEps),
+			s2delaunay.WithBorrowInput())
+		if err != nil {
+			return fmt.Errorf("WriteProgressive: level %d: %w", k, err)
+		}
+		next := canonicalTriangles(dt.Triangles)
+
+		var b wire.Writer
+		b.Uvarint(uint64(hi - lo))
+		for i := lo; i < hi; i++ {
+			b.Uvarint(uint64(order[i]))
+			b.Float64(sites[i].X)
+			b.Float64(sites[i].Y)
+			b.Float64(sites[i].Z)
+		}
+		removed, added := diffTriangles(prev, next)
+		b.Uvarint(uint64(len(removed)))
+		last := 0
+		for _, i := range removed {
+			b.Uvarint(uint64(i - last))
+			last = i
+		}
+		b.Uvarint(uint64(len(added)))
+		last = 0
+		for _, tri := range added {
+			b.Uvarint(uint64(tri[0] - last))
+			b.Uvarint(uint64(tri[1] - tri[0]))
+			b.Uvarint(uint64(tri[2] - tri[0]))
+			last = tri[0]
+		}
+
+		block := binary.LittleEndian.AppendUint32(nil, uint32(len(b.Bytes())))
+		if _, err := w.Write(append(block, b.Bytes()...)); err != nil {
+			return fmt.Errorf("WriteProgressive: %w", err)
+		}
+		prev, lo = next, hi
+	}
+	return nil
+}
+
+// ReadProgressive decodes the levels 0 through level of a stream written by WriteProgressive,
+// reading nothing past them, and returns the diagram of the sites received so far with default
+// options. It equals NewDiagram of those sites in order, up to the order of the vertices. The
+// second result maps each site of the diagram to its index in the encoded diagram.
+// It returns an error wrapping ErrOutOfRange if the stream has no such level, and an error
+// wrapping ErrInvalidEncoding if the data is malformed.
+func ReadProgressive(r io.Reader, level int) (*Diagram, []int, error) {
+	buf := make([]byte, progressiveHeaderSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, nil, fmt.Errorf("ReadProgressive: %w: %w", ErrInvalidEncoding, err)
+	}
+	header := wire.NewReader(buf)
+	magic, version := header.Uint32(), header.Uint32()
+	dual := DualType(header.Uint32())
+	total, levels := int(header.Uint32()), int(header.Uint32())
+	if magic != progressiveMagic || version != progressiveVersion {
+		return nil, nil, fmt.Errorf("ReadProgressive: %w: bad magic %#x or version %d",
+			ErrInvalidEncoding, magic, version)
+	}
+	if dual != CircumcentricDual && dual != BarycentricDual {
+		return nil, nil, fmt.Errorf("ReadProgressive: %w: unknown dual type %d",
+			ErrInvalidEncoding, dual)
+	}
+	if level < 0 || level >= levels {
+		return nil, nil, fmt.Errorf("ReadProgressive: level %d %w [0 %d)", level,
+			ErrOutOfRange, levels)
+	}
+
+	var (
+		sites s2.PointVector
+		ids   []int
+		tris  []s2delaunay.Triangle
+	)
+	for k := 0; k <= level; k++ {
+		var err error
+		sites, ids, tris, err = readProgressiveLevel(r, sites, ids, tris, total)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ReadProgressive: %w: level %d: %w", ErrInvalidEncoding,
+				k, err)
+		}
+	}
+
+	dt, err := s2delaunay.FromMesh(sites, tris, s2delaunay.WithBorrowInput())
+	if err != nil {
+		return nil, nil, fmt.Errorf("ReadProgressive: %w: %w", ErrInvalidEncoding, err)
+	}
+	opts, err := newDiagramOptions(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	d := &Diagram{Dual: dual, opts: opts}
+	if err := d.fromTriangulation(dt, nil); err != nil {
+		return nil, nil, fmt.Errorf("ReadProgressive: %w", err)
+	}
+	return d, ids, nil
+}
+
+// readProgressiveLevel reads the block of one level and applies it to the sites, their
+// original indices and the sorted triangles of the previous level.
+func readProgressiveLevel(r io.Reader, sites s2.PointVector, ids []int,
+	tris []s2delaunay.Triangle, total int) (s2.PointVector, []int, []s2delaunay.Triangle, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, nil, nil, err
+	}
+	buf := make([]byte, binary.LittleEndian.Uint32(size[:]))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, nil, nil, err
+	}
+	b := wire.NewReader(buf)
+
+	// Every entry takes at least one byte, which bounds the counts before allocating.
+	n := int(b.Uvarint())
+	if n > b.Len() || len(sites)+n > total {
+		return nil, nil, nil, fmt.Errorf("%d sites", n)
+	}
+	for range n {
+		id := int(b.Uvarint())
+		x, y, z := b.Float64(), b.Float64(), b.Float64()
+		ids = append(ids, id)
+		sites = append(sites, s2.Point{Vector: r3.Vector{X: x, Y: y, Z: z}})
+	}
+
+	n = int(b.Uvarint())
+	if n > b.Len() || n > len(tris) {
+		return nil, nil, nil, fmt.Errorf("%d removed triangles", n)
+	}
+	drop := make([]bool, len(tris))
+	last := 0
+	for range n {
+		last += int(b.Uvarint())
+		if last >= len(tris) {
+			return nil, nil, nil, fmt.Errorf("removed triangle %d %w [0 %d)", last,
+				ErrOutOfRange, len(tris))
+		}
+		drop[last] = true
+	}
+	kept := make([]s2delaunay.Triangle, 0, len(tris))
+	for i, tri := range tris {
+		if !drop[i] {
+			kept = append(kept, tri)
+		}
+	}
+
+	n = int(b.Uvarint())
+	if n > b.Len() {
+		return nil, nil, nil, fmt.Errorf("%d added triangles", n)
+	}
+	last = 0
+	for range n {
+		a := last + int(b.Uvarint())
+		kept = append(kept, s2delaunay.Triangle{a, a + int(b.Uvarint()), a + int(b.Uvarint())})
+		last = a
+	}
+	if err := b.Close(); err != nil {
+		return nil, nil, nil, err
+	}
+	slices.SortFunc(kept, compareTriangles)
+	return sites, ids, kept, nil
+}
+
+// progressiveSizes returns the number of sites in each level of a progressive encoding of n
+// sites.
+func progressiveSizes(n, levels int) []int {
+	sizes := make([]int, levels)
+	size := n
+	for k := levels - 1; k >= 0; k-- {
+		sizes[k] = size
+		size = min(size, max(4, (size+progressiveRatio-1)/progressiveRatio))
+	}
+	return sizes
+}
+
+// farthestPointOrder returns the indices of the sites in farthest-point sampling order starting
+// from site 0: each next site is the one farthest from all sites before it, ties broken by the
+// lowest index.
+func farthestPointOrder(sites s2.PointVector) []int {
+	order := make([]int, 0, len(sites))
+	if len(sites) == 0 {
+		return order
+	}
+	// Distances are compared by the dot product with the nearest chosen site.
+	nearest := make([]float64, len(sites))
+	for i := range nearest {
+		nearest[i] = -2
+	}
+	chosen := make([]bool, len(sites))
+	next := 0
+	for range sites {
+		order = append(order, next)
+		chosen[next] = true
+		p := sites[next]
+		next = -1
+		for i, s := range sites {
+			if chosen[i] {
+				continue
+			}
+			nearest[i] = max(nearest[i], p.Dot(s.Vector))
+			if next < 0 || nearest[i] < nearest[next] {
+				next = i
+			}
+		}
+	}
+	return order
+}
+
+// canonicalTriangles returns the triangles rotated to start at their smallest vertex, which
+// keeps them CCW, and sorted.
+func canonicalTriangles(tris []s2delaunay.Triangle) []s2delaunay.Triangle {
+	out := make([]s2delaunay.Triangle, len(tris))
+	for i, tri := range tris {
+		for tri[0] > tri[1] || tri[0] > tri[2] {
+			tri = s2delaunay.Triangle{tri[1], tri[2], tri[0]}
+		}
+		out[i] = tri
+	}
+	slices.SortFunc(out, compareTriangles)
+	return out
+}
+
+// compareTriangles orders triangles lexicographically by their vertex indices.
+func compareTriangles(x, y s2delaunay.Triangle) int {
+	return slices.Compare(x[:], y[:])
+}
+
+// diffTriangles returns the indices into prev of the triangles missing from next, and the
+// triangles of next missing from prev, for two sorted triangle lists.
+func diffTriangles(prev, next []s2delaunay.Triangle) (removed []int,
+	added []s2delaunay.Triangle) {
+	i, j := 0, 0
+	for i < len(prev) || j < len(next) {
+		switch {
+		case j == len(next) || (i < len(prev) && compareTriangles(prev[i], next[j]) < 0):
+			removed = append(removed, i)
+			i++
+		case i == len(prev) || compareTriangles(prev[i], next[j]) > 0:
+			added = append(added, next[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return removed, added
+}
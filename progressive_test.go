@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/s2"
+)
+
+// Progressive encoding
+
+func TestWriteProgressive(t *testing.T) {
+	vd := mustNewDiagram(t, 1000)
+	var buf bytes.Buffer
+	if err := WriteProgressive(&buf, vd, 4); err != nil {
+		t.Fatalf("WriteProgressive(...) error = %v, want nil", err)
+	}
+
+	full := 0
+	wantSizes := []int{16, 63, 250, 1000}
+	for k, n := range wantSizes {
+		got, ids, err := ReadProgressive(bytes.NewReader(buf.Bytes()), k)
+		if err != nil {
+			t.Fatalf("ReadProgressive(..., %d) error = %v, want nil", k, err)
+		}
+		if got.NumCells() != n || len(ids) != n {
+			t.Fatalf("ReadProgressive(..., %d) has %d cells and %d ids, want %d", k,
+				got.NumCells(), len(ids), n)
+		}
+		sites := make(s2.PointVector, n)
+		for i, id := range ids {
+			sites[i] = vd.Sites[id]
+		}
+		want, err := NewDiagram(sites)
+		if err != nil {
+			t.Fatalf("NewDiagram(prefix %d) error = %v, want nil", k, err)
+		}
+		data, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatalf("want.MarshalBinary() error = %v, want nil", err)
+		}
+		full += len(data)
+
+		if !slices.Equal(got.Sites, want.Sites) {
+			t.Errorf("level %d sites differ from the prefix", k)
+		}
+		gotAreas, wantAreas := got.CellAreas(), want.CellAreas()
+		for i := range n {
+			a := Cell{idx: i, d: got}.NeighborIndices()
+			b := Cell{idx: i, d: want}.NeighborIndices()
+			if !slices.Equal(canonicalRing(a), canonicalRing(b)) {
+				t.Errorf("level %d cell %d neighbors = %v, want %v", k, i, a, b)
+			}
+			if math.Abs(gotAreas[i]-wantAreas[i]) > 1e-12 {
+				t.Errorf("level %d cell %d area = %v, want %v", k, i, gotAreas[i], wantAreas[i])
+			}
+		}
+		if r := Check(got); !r.OK() {
+			t.Errorf("Check(level %d) failed:\n%s", k, r)
+		}
+	}
+	if 3*buf.Len() >= full {
+		t.Errorf("progressive size = %d, want less than a third of %d", buf.Len(), full)
+	}
+
+	truncated := buf.Bytes()[:buf.Len()-1]
+	if _, _, err := ReadProgressive(bytes.NewReader(truncated), 2); err != nil {
+		t.Errorf("ReadProgressive(truncated, 2) error = %v, want nil", err)
+	}
+	if _, _, err := ReadProgressive(bytes.NewReader(truncated), 3); !errors.Is(err,
+		ErrInvalidEncoding) {
+		t.Errorf("ReadProgressive(truncated, 3) error = %v, want %v", err, ErrInvalidEncoding)
+	}
+}
+
+func TestWriteProgressive_Invalid(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	if err := WriteProgressive(new(bytes.Buffer), vd, 0); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("WriteProgressive(..., 0) error = %v, want %v", err, ErrOutOfRange)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteProgressive(&buf, vd, 2); err != nil {
+		t.Fatalf("WriteProgressive(...) error = %v, want nil", err)
+	}
+	for _, level := range []int{-1, 2} {
+		if _, _, err := ReadProgressive(bytes.NewReader(buf.Bytes()), level); !errors.Is(err,
+			ErrOutOfRange) {
+			t.Errorf("ReadProgressive(..., %d) error = %v, want %v", level, err, ErrOutOfRange)
+		}
+	}
+	data := slices.Clone(buf.Bytes())
+	data[0] ^= 0xff
+	if _, _, err := ReadProgressive(bytes.NewReader(data), 0); !errors.Is(err,
+		ErrInvalidEncoding) {
+		t.Errorf("ReadProgressive(bad magic) error = %v, want %v", err, ErrInvalidEncoding)
+	}
+}
+
+func TestFarthestPointOrder(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	order := farthestPointOrder(vd.Sites)
+	for i, v := range slices.Sorted(slices.Values(order)) {
+		if v != i {
+			t.Fatalf("farthestPointOrder(...) = %v, want a permutation", order)
+		}
+	}
+	far := 0
+	for i, s := range vd.Sites {
+		if s.Distance(vd.Sites[0]) > vd.Sites[far].Distance(vd.Sites[0]) {
+			far = i
+		}
+	}
+	if order[0] != 0 || order[1] != far {
+		t.Errorf("farthestPointOrder(...)[:2] = %v, want [0 %d]", order[:2], far)
+	}
+}
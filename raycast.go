@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// rayTieEps is the tolerance under which two boundary crossings along a ray are treated as
+	// one, where the ray passes through a Voronoi vertex.
+	rayTieEps = 1e-12
+	// rayStep is how far past a Voronoi vertex the entered cell is located.
+	rayStep = 1e-9
+)
+
+// DiagramEdge is a Voronoi edge, the arc of the ring of a cell shared with one neighbor.
+type DiagramEdge struct {
+	// Cells are the cell whose ring lists the edge and the neighbor across it.
+	Cells [2]int
+	// Vertices are the indices of the endpoints in the ring order of Cells[0].
+	Vertices [2]int
+}
+
+// CastRay follows the great circle leaving from in the direction of the component of direction
+// tangent to the sphere at from, and returns the first point where it leaves the cell
+// containing from, the edge crossed there and the index of the cell entered, provided the
+// point is at most maxAngle from from. Like ExtentToward, crossings are found against the
+// separating planes of the cell, so they match CellContainingPoint on the ray.
+// When the ray passes through a Voronoi vertex, the entered cell is the one containing the ray
+// just past the vertex, and the edge is the one shared with it if the cells are adjacent, or
+// else the first of the edges meeting at the vertex in ring order.
+// It returns false if direction has no tangent component, the boundary is beyond maxAngle or
+// the diagram was built WithoutNeighbors.
+func (d *Diagram) CastRay(from, direction s2.Point, maxAngle s1.Angle) (hit s2.Point,
+	edge DiagramEdge, cell int, ok bool) {
+	if !d.hasNeighbors() {
+		return s2.Point{}, DiagramEdge{}, 0, false
+	}
+	from = s2.Point{Vector: from.Normalize()}
+	tangent := direction.Sub(from.Mul(direction.Dot(from.Vector)))
+	if tangent.Norm2() == 0 {
+		return s2.Point{}, DiagramEdge{}, 0, false
+	}
+	tangent = tangent.Normalize()
+	at := func(theta float64) s2.Point {
+		return s2.Point{Vector: from.Mul(math.Cos(theta)).Add(tangent.Mul(math.Sin(theta))).
+			Normalize()}
+	}
+
+	// The cell lies in the hemisphere on the positive side of each separating plane, so the
+	// ray leaves it before θ = π. Crossings are computed as in ExtentToward.
+	start := Cell{idx: d.locate(from, 0), d: d}
+	planes := start.SeparatingPlanes()
+	thetas := make([]float64, len(planes))
+	exit := math.Pi
+	for k, m := range planes {
+		thetas[k] = max(0, math.Atan2(m.Dot(from.Vector), -m.Dot(tangent)))
+		exit = min(exit, thetas[k])
+	}
+	if s1.Angle(exit) > maxAngle {
+		return s2.Point{}, DiagramEdge{}, 0, false
+	}
+
+	neighbors := start.NeighborIndices()
+	var tied []int
+	for k, theta := range thetas {
+		if theta-exit <= rayTieEps {
+			tied = append(tied, k)
+		}
+	}
+	k := tied[0]
+	cell = neighbors[k]
+	if len(tied) > 1 {
+		cell = d.locate(at(exit+rayStep), cell)
+		for _, j := range tied {
+			if neighbors[j] == cell {
+				k = j
+				break
+			}
+		}
+	}
+
+	vertices := start.VertexIndices()
+	edge = DiagramEdge{
+		Cells:    [2]int{start.idx, neighbors[k]},
+		Vertices: [2]int{vertices[k], vertices[(k+1)%len(vertices)]},
+	}
+	return at(exit), edge, cell, true
+}
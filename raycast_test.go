@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Ray casting
+
+func TestDiagram_CastRay(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	starts := utils.GenerateRandomPoints(50, 1)
+	directions := utils.GenerateRandomPoints(50, 2)
+	const step = 1e-4
+	for i, from := range starts {
+		hit, edge, cell, ok := vd.CastRay(from, directions[i], math.Pi)
+		if !ok {
+			t.Fatalf("vd.CastRay(starts[%d], ...) ok = false, want true", i)
+		}
+		start := vd.CellContainingPoint(from).SiteIndex()
+		if edge.Cells[0] != start || edge.Cells[1] != cell {
+			t.Errorf("ray %d: edge.Cells = %v, want [%d %d]", i, edge.Cells, start, cell)
+		}
+		other := Cell{idx: cell, d: vd}.VertexIndices()
+		if !slices.Contains(other, edge.Vertices[0]) || !slices.Contains(other, edge.Vertices[1]) {
+			t.Errorf("ray %d: edge %v is not on the ring of cell %d", i, edge.Vertices, cell)
+		}
+		if d0, d1 := hit.Distance(vd.Sites[start]), hit.Distance(vd.Sites[cell]); math.Abs(
+			(d0 - d1).Radians()) > 1e-12 {
+			t.Errorf("ray %d: hit distances to the sites = %v, %v, want equal", i, d0, d1)
+		}
+
+		// Walk the ray in small steps to the first sample outside the start cell.
+		dist := from.Distance(hit).Radians()
+		tangent := from.Cross(directions[i].Vector).Cross(from.Vector).Normalize()
+		for theta := step; theta < math.Pi; theta += step {
+			p := s2.Point{Vector: from.Mul(math.Cos(theta)).Add(tangent.Mul(math.Sin(theta)))}
+			got := vd.CellContainingPoint(p).SiteIndex()
+			if got == start {
+				continue
+			}
+			if dist <= theta-step || dist > theta {
+				t.Errorf("ray %d: CastRay distance = %v, want in (%v, %v]", i, dist, theta-step,
+					theta)
+			}
+			if got != cell {
+				t.Errorf("ray %d: sampled cell = %d, want %d", i, got, cell)
+			}
+			break
+		}
+	}
+}
+
+func TestDiagram_CastRay_Vertex(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	c := Cell{idx: 0, d: vd}
+	v := vd.Vertices[c.VertexIndices()[0]]
+	hit, edge, cell, ok := vd.CastRay(c.Site(), v, math.Pi)
+	if !ok {
+		t.Fatalf("vd.CastRay(site, vertex, π) ok = false, want true")
+	}
+	if !hit.ApproxEqual(v) {
+		t.Errorf("hit = %v, want vertex %v", hit, v)
+	}
+	want := vd.CellContainingPoint(s2.Interpolate(1+1e-6, c.Site(), v)).SiteIndex()
+	if cell != want || edge.Cells[0] != 0 {
+		t.Errorf("cell, edge.Cells = %d, %v, want %d from cell 0", cell, edge.Cells, want)
+	}
+	if !slices.Contains(edge.Vertices[:], c.VertexIndices()[0]) {
+		t.Errorf("edge.Vertices = %v, want the vertex hit", edge.Vertices)
+	}
+}
+
+func TestDiagram_CastRay_Miss(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	site := vd.Sites[0]
+	if _, _, _, ok := vd.CastRay(site, site, math.Pi); ok {
+		t.Errorf("vd.CastRay(site, site, π) ok = true, want false without a tangent")
+	}
+	dir := s2.Ortho(site)
+	if _, _, _, ok := vd.CastRay(site, dir, s1.Angle(1e-6)); ok {
+		t.Errorf("vd.CastRay(site, dir, 1e-6) ok = true, want false before the boundary")
+	}
+	extent, err := Cell{idx: 0, d: vd}.ExtentToward(dir.Vector)
+	if err != nil {
+		t.Fatalf("ExtentToward(...) error = %v, want nil", err)
+	}
+	hit, _, _, ok := vd.CastRay(site, dir, math.Pi)
+	if !ok || math.Abs((site.Distance(hit)-extent).Radians()) > 1e-12 {
+		t.Errorf("vd.CastRay(site, dir, π) distance = %v, want ExtentToward %v",
+			site.Distance(hit), extent)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+
+	"github.com/golang/geo/r1"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// CellsIntersectingRect returns the indices of the cells whose boundary or interior intersects
+// the closed lat/lng rectangle, in ascending order. Cells are prefiltered by their bounding
+// rectangles and then tested exactly against the rectangle edges, which are meridians and
+// parallels. Rectangles spanning the antimeridian or containing a pole are supported.
+func (d *Diagram) CellsIntersectingRect(r s2.Rect) []int {
+	if r.IsEmpty() {
+		return nil
+	}
+	var cells []int
+	for i := range d.NumCells() {
+		l := Cell{idx: i, d: d}.Loop()
+		if r.Intersects(l.RectBound()) && rectIntersectsLoop(r, l) {
+			cells = append(cells, i)
+		}
+	}
+	return cells
+}
+
+// rectIntersectsLoop reports whether the closed rectangle intersects the loop, following the
+// approach of s2.Rect.IntersectsCell: either one contains a vertex of the other or their
+// boundaries cross.
+func rectIntersectsLoop(r s2.Rect, l *s2.Loop) bool {
+	if l.ContainsPoint(s2.PointFromLatLng(r.Center())) {
+		return true
+	}
+	for i := range 4 {
+		if l.ContainsPoint(s2.PointFromLatLng(r.Vertex(i))) {
+			return true
+		}
+	}
+	vertices := l.Vertices()
+	for _, v := range vertices {
+		if r.ContainsPoint(v) {
+			return true
+		}
+	}
+
+	for i, a := range vertices {
+		b := vertices[(i+1)%len(vertices)]
+		// A full longitude range has no meridian edges.
+		if !r.Lng.IsFull() {
+			if intersectsLngEdge(a, b, r.Lat, s1.Angle(r.Lng.Lo)) ||
+				intersectsLngEdge(a, b, r.Lat, s1.Angle(r.Lng.Hi)) {
+				return true
+			}
+		}
+		if intersectsLatEdge(a, b, s1.Angle(r.Lat.Lo), r.Lng) ||
+			intersectsLatEdge(a, b, s1.Angle(r.Lat.Hi), r.Lng) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectsLatEdge reports whether the edge AB intersects the parallel at latitude lat within
+// the longitude interval lng. A parallel can cross a geodesic edge in up to two points.
+func intersectsLatEdge(a, b s2.Point, lat s1.Angle, lng s1.Interval) bool {
+	// Normal of the plane AB pointing north, extended to a frame (x, y, z) where x is the
+	// direction in which the great circle through AB reaches its maximum latitude.
+	z := s2.Point{Vector: a.PointCross(b).Normalize()}
+	if z.Z < 0 {
+		z = s2.Point{Vector: z.Mul(-1)}
+	}
+	y := s2.Point{Vector: z.PointCross(northPole).Normalize()}
+	x := y.Cross(z.Vector)
+
+	sinLat := math.Sin(float64(lat))
+	if math.Abs(sinLat) >= x.Z {
+		return false
+	}
+
+	// The great circle meets the parallel at angles ±theta from x.
+	cosTheta := sinLat / x.Z
+	sinTheta := math.Sqrt(1 - cosTheta*cosTheta)
+	theta := math.Atan2(sinTheta, cosTheta)
+	abTheta := s1.IntervalFromPointPair(
+		math.Atan2(a.Dot(y.Vector), a.Dot(x)),
+		math.Atan2(b.Dot(y.Vector), b.Dot(x)))
+
+	for _, sign := range [2]float64{1, -1} {
+		if !abTheta.Contains(sign * theta) {
+			continue
+		}
+		isect := x.Mul(cosTheta).Add(y.Mul(sign * sinTheta))
+		if lng.Contains(math.Atan2(isect.Y, isect.X)) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectsLngEdge reports whether the edge AB crosses the meridian segment at longitude lng
+// spanning the latitude interval lat.
+func intersectsLngEdge(a, b s2.Point, lat r1.Interval, lng s1.Angle) bool {
+	return s2.CrossingSign(a, b,
+		s2.PointFromLatLng(s2.LatLng{Lat: s1.Angle(lat.Lo), Lng: lng}),
+		s2.PointFromLatLng(s2.LatLng{Lat: s1.Angle(lat.Hi), Lng: lng})) == s2.Cross
+}
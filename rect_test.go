@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/r1"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Rect
+
+func TestDiagram_CellsIntersectingRect(t *testing.T) {
+	vd := mustNewDiagram(t, 1000)
+	deg := func(d float64) float64 { return (s1.Angle(d) * s1.Degree).Radians() }
+	tests := []struct {
+		name string
+		r    s2.Rect
+	}{
+		{"tile", s2.Rect{Lat: r1.Interval{Lo: deg(10), Hi: deg(30)},
+			Lng: s1.Interval{Lo: deg(40), Hi: deg(70)}}},
+		{"antimeridian", s2.Rect{Lat: r1.Interval{Lo: deg(-20), Hi: deg(5)},
+			Lng: s1.Interval{Lo: deg(165), Hi: deg(-170)}}},
+		{"north pole", s2.Rect{Lat: r1.Interval{Lo: deg(75), Hi: deg(90)},
+			Lng: s1.FullInterval()}},
+		{"south pole wedge", s2.Rect{Lat: r1.Interval{Lo: deg(-90), Hi: deg(-70)},
+			Lng: s1.Interval{Lo: deg(-30), Hi: deg(60)}}},
+		{"tiny", s2.Rect{Lat: r1.Interval{Lo: deg(0.001), Hi: deg(0.002)},
+			Lng: s1.Interval{Lo: deg(0.001), Hi: deg(0.002)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := vd.CellsIntersectingRect(tt.r)
+			if !slices.IsSorted(got) {
+				t.Errorf("vd.CellsIntersectingRect(...) = %v, want ascending", got)
+			}
+
+			// Every cell hit by a dense sample of the rectangle must be selected.
+			const steps = 300
+			hit := make(map[int]bool)
+			for i := range steps + 1 {
+				lat := tt.r.Lat.Lo + tt.r.Lat.Length()*float64(i)/steps
+				for j := range steps + 1 {
+					lng := tt.r.Lng.Lo + tt.r.Lng.Length()*float64(j)/steps
+					p := s2.PointFromLatLng(s2.LatLng{Lat: s1.Angle(lat), Lng: s1.Angle(lng)})
+					hit[vd.CellContainingPoint(p).SiteIndex()] = true
+				}
+			}
+			for i := range hit {
+				if !slices.Contains(got, i) {
+					t.Errorf("cell %d hit by samples is missing", i)
+				}
+			}
+
+			// Selected cells not hit by samples must come close to the rectangle.
+			const tol = 0.01
+			near := s2.Rect{Lat: tt.r.Lat.Expanded(tol), Lng: tt.r.Lng.Expanded(tol)}
+			for _, i := range got {
+				if !hit[i] && !loopNearRect(Cell{idx: i, d: vd}.Loop(), near) {
+					t.Errorf("cell %d is selected but does not approach the rectangle", i)
+				}
+			}
+		})
+	}
+}
+
+// loopNearRect reports whether a dense sample of the loop edges falls inside r.
+func loopNearRect(l *s2.Loop, r s2.Rect) bool {
+	vertices := l.Vertices()
+	for i, a := range vertices {
+		b := vertices[(i+1)%len(vertices)]
+		for k := range 100 {
+			if r.ContainsPoint(s2.Interpolate(float64(k)/100, a, b)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// IterationReport summarizes how a diagram changed between two relaxation iterations.
+type IterationReport struct {
+	// MaxDisplacement is the largest angular distance a site moved.
+	MaxDisplacement s1.Angle
+	// CellsWithChangedNeighbors is the number of cells whose cyclic neighbor ring changed.
+	CellsWithChangedNeighbors int
+	// Converged reports whether MaxDisplacement is within the requested tolerance.
+	Converged bool
+}
+
+// Relax performs one step of Lloyd relaxation, returning new sites with every site moved to the
+// Centroid of its cell, on the unit sphere and in the order of Sites. Feeding them back into
+// NewDiagram and repeating spreads the sites evenly. CompareIterations between the diagram and
+// the one built from the result reports the MaxDisplacement of the step, and whether it
+// Converged within a tolerance, to end the loop.
+// It returns an error if the cells are truncated, since Centroid does not account for
+// MaxRadius.
+func (d *Diagram) Relax() (s2.PointVector, error) {
+	if d.opts.MaxRadius > 0 {
+		return nil, fmt.Errorf("Relax: %w: truncated cells are not supported", ErrInvalidOption)
+	}
+	sites := make(s2.PointVector, d.NumCells())
+	for i := range sites {
+		sites[i] = Cell{idx: i, d: d}.Centroid()
+	}
+	return sites, nil
+}
+
+// CompareIterations reports the changes from prev to next, two diagrams built from the same
+// number of sites with corresponding indices. Neighbor rings are compared up to rotation, so a
+// ring that only starts at a different neighbor is not counted as changed.
+// It returns an error if the diagrams have different numbers of cells, or wrapping
+// ErrNoNeighbors if either was built WithoutNeighbors.
+func CompareIterations(prev, next *Diagram, tol s1.Angle) (IterationReport, error) {
+	if !prev.hasNeighbors() || !next.hasNeighbors() {
+		return IterationReport{}, fmt.Errorf("CompareIterations: %w", ErrNoNeighbors)
+	}
+	if prev.NumCells() != next.NumCells() {
+		return IterationReport{},
+			fmt.Errorf("CompareIterations: cell count mismatch %d != %d", prev.NumCells(),
+				next.NumCells())
+	}
+
+	var r IterationReport
+	for i := range prev.NumCells() {
+		r.MaxDisplacement = max(r.MaxDisplacement, prev.Sites[i].Distance(next.Sites[i]))
+
+		a := Cell{idx: i, d: prev}.NeighborIndices()
+		b := Cell{idx: i, d: next}.NeighborIndices()
+		if !slices.Equal(canonicalRing(a), canonicalRing(b)) {
+			r.CellsWithChangedNeighbors++
+		}
+	}
+	r.Converged = r.MaxDisplacement <= tol
+
+	return r, nil
+}
+
+// canonicalRing returns a copy of the cyclic ring rotated to start at its smallest element.
+func canonicalRing(ring []int) []int {
+	if len(ring) == 0 {
+		return nil
+	}
+	start := 0
+	for i, v := range ring {
+		if v < ring[start] {
+			start = i
+		}
+	}
+	out := make([]int, 0, len(ring))
+	out = append(out, ring[start:]...)
+	return append(out, ring[:start]...)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Iterations
+
+func TestDiagram_Relax(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	spread := func(d *Diagram) float64 {
+		lo, hi := math.Inf(1), 0.0
+		for _, a := range d.CellAreas() {
+			lo, hi = min(lo, a), max(hi, a)
+		}
+		return hi / lo
+	}
+	initial := spread(vd)
+
+	// Relax until no site moves by more than 1e-3 radians in a step.
+	const tol = 1e-3
+	converged := false
+	for step := 0; step < 500 && !converged; step++ {
+		sites, err := vd.Relax()
+		if err != nil {
+			t.Fatalf("vd.Relax() error = %v, want nil", err)
+		}
+		next, err := NewDiagram(sites)
+		if err != nil {
+			t.Fatalf("step %d: NewDiagram(relaxed) error = %v, want nil", step, err)
+		}
+		r, err := CompareIterations(vd, next, tol)
+		if err != nil {
+			t.Fatalf("CompareIterations(...) error = %v, want nil", err)
+		}
+		vd, converged = next, r.Converged
+	}
+	if !converged {
+		t.Fatalf("relaxation did not converge within 500 steps")
+	}
+	if got := spread(vd); got >= initial || got > 2 {
+		t.Errorf("max/min cell area = %v after relaxation, want below %v and 2", got, initial)
+	}
+	for i, p := range vd.Sites {
+		if math.Abs(p.Norm()-1) > 1e-15 {
+			t.Errorf("vd.Sites[%d] norm = %v, want 1", i, p.Norm())
+		}
+	}
+
+	truncated, err := NewTruncatedDiagram(utils.GenerateRandomPoints(50, 0), 0.1)
+	if err != nil {
+		t.Fatalf("NewTruncatedDiagram(...) error = %v, want nil", err)
+	}
+	if _, err := truncated.Relax(); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("truncated.Relax() error = %v, want %v", err, ErrInvalidOption)
+	}
+}
+
+func TestCompareIterations_TinyDisplacement(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	prev, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	moved := make(s2.PointVector, len(points))
+	for i, p := range points {
+		moved[i] = s2.Point{Vector: p.Add(s2.Ortho(p).Mul(1e-10)).Normalize()}
+	}
+	next, err := NewDiagram(moved)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	r, err := CompareIterations(prev, next, 1e-9)
+	if err != nil {
+		t.Fatalf("CompareIterations(...) error = %v, want nil", err)
+	}
+	if r.CellsWithChangedNeighbors != 0 {
+		t.Errorf("r.CellsWithChangedNeighbors = %d, want 0", r.CellsWithChangedNeighbors)
+	}
+	if r.MaxDisplacement <= 0 || r.MaxDisplacement > 1e-9 {
+		t.Errorf("r.MaxDisplacement = %v, want in (0, 1e-9]", r.MaxDisplacement)
+	}
+	if !r.Converged {
+		t.Errorf("r.Converged = false, want true")
+	}
+}
+
+func TestCompareIterations_ChangedNeighbors(t *testing.T) {
+	prev := mustNewDiagram(t, 100)
+	other, err := NewDiagram(utils.GenerateRandomPoints(100, 1))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	r, err := CompareIterations(prev, other, 1e-9)
+	if err != nil {
+		t.Fatalf("CompareIterations(...) error = %v, want nil", err)
+	}
+	if r.CellsWithChangedNeighbors == 0 {
+		t.Errorf("r.CellsWithChangedNeighbors = 0, want > 0")
+	}
+	if r.Converged {
+		t.Errorf("r.Converged = true, want false")
+	}
+
+	if _, err := CompareIterations(prev, mustNewDiagram(t, 10), 0); err == nil {
+		t.Errorf("CompareIterations(...) error = nil, want non-nil for mismatched sizes")
+	}
+}
+
+func TestCanonicalRing(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", nil, nil},
+		{"sorted", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"rotated", []int{5, 9, 2, 7}, []int{2, 7, 5, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := canonicalRing(tt.in)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("canonicalRing(%v) mismatch (-want +got):\n%s", tt.in, diff)
+			}
+		})
+	}
+}
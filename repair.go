@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math/big"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// repairPrec is the mantissa precision in bits of the arithmetic used by WithRingRepair.
+	repairPrec = 256
+)
+
+// WithRingRepair recomputes the vertices of every cell whose ring is not simple, as reported
+// by the simple check of Check, in extended precision after each build.
+// Sites are unit vectors only up to rounding, and for sites closer than about 1e-7 radians
+// that rounding tilts their float64 bisector enough for a site to end up outside its own
+// cell. The repair normalizes the three sites of each affected triangle exactly before taking
+// the circumcenter. Vertices supplied by VertexOverride are kept.
+// NewBarycentricDualDiagram rejects it with an OptionError, since barycentric rings need not
+// turn around their sites.
+func WithRingRepair() DiagramOption {
+	return func(o *DiagramOptions) error {
+		o.RingRepair = true
+		return nil
+	}
+}
+
+// repairRings recomputes the vertices of the cells whose rings are not simple, where vertex v
+// is dual to triangles[v].
+func (d *Diagram) repairRings(triangles []s2delaunay.Triangle) {
+	if d.Dual != CircumcentricDual {
+		return
+	}
+	done := make(map[int]bool)
+	for i := range d.NumCells() {
+		if d.ringSimple(i) {
+			continue
+		}
+		for _, v := range (Cell{idx: i, d: d}).VertexIndices() {
+			if done[v] {
+				continue
+			}
+			done[v] = true
+			tri := triangles[v]
+			p := [3]s2.Point{d.Sites[tri[0]], d.Sites[tri[1]], d.Sites[tri[2]]}
+			if d.opts.VertexOverride != nil {
+				if _, ok := d.opts.VertexOverride(p, v); ok {
+					continue
+				}
+			}
+			d.Vertices[v] = exactCircumcenter(p)
+		}
+	}
+}
+
+// ringSimple reports whether the ring of cell i has no two crossing edges and, for a
+// circumcentric diagram, turns around the site on every edge, so that the site is inside.
+// Zero-length edges between coincident vertices are allowed.
+func (d *Diagram) ringSimple(i int) bool {
+	c := Cell{idx: i, d: d}
+	indices := c.VertexIndices()
+	n := len(indices)
+	ring := make([]s2.Point, n)
+	for k, v := range indices {
+		ring[k] = d.Vertices[v]
+	}
+	if d.Dual == CircumcentricDual {
+		site := c.Site()
+		for k := range n {
+			// Rings are CCW when looking out of the sphere, which is CW in the s2 convention.
+			if s2.RobustSign(site, ring[k], ring[(k+1)%n]) == s2.CounterClockwise {
+				return false
+			}
+		}
+	}
+	for a := range n {
+		for b := a + 2; b < n; b++ {
+			if a == 0 && b == n-1 {
+				continue
+			}
+			if s2.CrossingSign(ring[a], ring[a+1], ring[b], ring[(b+1)%n]) == s2.Cross {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// exactCircumcenter computes the circumcenter of the triangle like dualVertex, but from the
+// exactly normalized vertices and in repairPrec-bit arithmetic, rounding only the result.
+func exactCircumcenter(p [3]s2.Point) s2.Point {
+	var u [3][3]*big.Float
+	for i, pt := range p {
+		x := []*big.Float{exactFloat(pt.X), exactFloat(pt.Y), exactFloat(pt.Z)}
+		norm := exactFloat(0)
+		for _, c := range x {
+			norm.Add(norm, exactFloat(0).Mul(c, c))
+		}
+		norm.Sqrt(norm)
+		for j, c := range x {
+			u[i][j] = c.Quo(c, norm)
+		}
+	}
+	var v1, v2 [3]*big.Float
+	for j := range 3 {
+		v1[j] = exactFloat(0).Sub(u[0][j], u[1][j])
+		v2[j] = exactFloat(0).Sub(u[1][j], u[2][j])
+	}
+	cross := func(i, j int) float64 {
+		f, _ := exactFloat(0).Sub(exactFloat(0).Mul(v1[i], v2[j]),
+			exactFloat(0).Mul(v1[j], v2[i])).Float64()
+		return f
+	}
+	c := r3.Vector{X: cross(1, 2), Y: cross(2, 0), Z: cross(0, 1)}
+	if s2.RobustSign(p[0], p[1], p[2]) == s2.Clockwise {
+		c = c.Mul(-1)
+	}
+	return s2.Point{Vector: c.Normalize()}
+}
+
+// exactFloat returns x as a big.Float with repairPrec bits of precision.
+func exactFloat(x float64) *big.Float {
+	return new(big.Float).SetPrec(repairPrec).SetFloat64(x)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Ring repair
+
+// nearDuplicatePoints returns 100 random points with a 101st point sep radians from the first.
+func nearDuplicatePoints(sep float64) s2.PointVector {
+	points := utils.GenerateRandomPoints(100, 0)
+	p := points[0]
+	return append(points, s2.Point{Vector: p.Add(s2.Ortho(p).Mul(sep)).Normalize()})
+}
+
+func TestWithRingRepair(t *testing.T) {
+	points := nearDuplicatePoints(1e-9)
+	plain, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	if simple := checkResult(Check(plain), "simple"); simple.Passed {
+		t.Fatalf("simple check passed without WithRingRepair(), want the float64 fold")
+	}
+
+	vd, err := NewDiagram(points, WithRingRepair())
+	if err != nil {
+		t.Fatalf("NewDiagram(..., WithRingRepair()) error = %v, want nil", err)
+	}
+	for i := range vd.NumCells() {
+		if !vd.ringSimple(i) {
+			t.Errorf("ring of cell %d is not simple", i)
+		}
+	}
+	if r := Check(vd); !r.OK() {
+		t.Errorf("Check(vd) failed:\n%s", r)
+	}
+
+	moved := nearDuplicatePoints(2e-9)
+	changed, err := vd.UpdateSitePositions(moved)
+	if err != nil || changed {
+		t.Fatalf("vd.UpdateSitePositions(...) = %v, %v, want false, nil", changed, err)
+	}
+	if simple := checkResult(Check(vd), "simple"); !simple.Passed {
+		t.Errorf("simple check after UpdateSitePositions = %+v, want passed", simple)
+	}
+}
+
+func TestExactCircumcenter(t *testing.T) {
+	p := [3]s2.Point{
+		s2.PointFromCoords(1, 0, 0),
+		s2.PointFromCoords(0, 1, 0),
+		s2.PointFromCoords(0, 0, 1),
+	}
+	want := s2.PointFromCoords(1, 1, 1)
+	if got := exactCircumcenter(p); !got.ApproxEqual(want) {
+		t.Errorf("exactCircumcenter(%v) = %v, want %v", p, got, want)
+	}
+	if got := exactCircumcenter([3]s2.Point{p[0], p[2], p[1]}); !got.ApproxEqual(want) {
+		t.Errorf("exactCircumcenter(CW) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// WithFixOrientation makes FromRings reverse the rings, and their neighbors, of cells listed in
+// the opposite orientation to VertexIndices instead of rejecting them. The other constructors
+// ignore it.
+func WithFixOrientation() DiagramOption {
+	return func(o *DiagramOptions) error {
+		o.FixOrientation = true
+		return nil
+	}
+}
+
+// FromRings creates a diagram from externally computed cells, such as the regions of a
+// scipy.spatial.SphericalVoronoi run, keeping the given vertices bit for bit. Cell i has site
+// sites[i], ring rings[i] of indices into vertices, and neighbors neighbors[i], where
+// neighbors[i][k] is the cell across the edge from ring vertex k to ring vertex k+1, as in
+// CellVertices and CellNeighbors. There must be 2(n-2) vertices for n sites, one per Delaunay
+// triangle.
+// The cells are validated: every ring is oriented around its site like VertexIndices, or is
+// reversed under WithFixOrientation; every ring edge is shared, in the opposite
+// direction, by the neighbor listed against it, which lists the cell back; and every vertex is
+// equidistant from the sites of its cells within the override tolerance. The diagram stores
+// copies of the input and is CircumcentricDual.
+// It returns an error if an option is invalid or the cells fail validation, which wraps
+// ErrInvalidRings and names the offending cell or vertex.
+func FromRings(sites, vertices s2.PointVector, rings, neighbors [][]int,
+	setters ...DiagramOption) (*Diagram, error) {
+	opts, err := newDiagramOptions(setters)
+	if err != nil {
+		return nil, err
+	}
+	numCells := len(sites)
+	if numCells < 4 {
+		return nil, fmt.Errorf("FromRings: %w", ErrInsufficientSites)
+	}
+	if len(rings) != numCells || len(neighbors) != numCells {
+		return nil, fmt.Errorf("FromRings: %w: got %d rings and %d neighbor lists for %d sites",
+			ErrInvalidRings, len(rings), len(neighbors), numCells)
+	}
+
+	d := &Diagram{
+		Sites:       slices.Clone(sites),
+		Vertices:    slices.Clone(vertices),
+		CellOffsets: make([]int, numCells+1),
+		Dual:        CircumcentricDual,
+		opts:        opts,
+	}
+	for i, ring := range rings {
+		if len(ring) < 3 || len(neighbors[i]) != len(ring) {
+			return nil, fmt.Errorf("FromRings: %w: cell %d has %d vertices and %d neighbors",
+				ErrInvalidRings, i, len(ring), len(neighbors[i]))
+		}
+		d.CellOffsets[i+1] = d.CellOffsets[i] + len(ring)
+	}
+	d.CellVertices = make([]int, 0, d.CellOffsets[numCells])
+	d.CellNeighbors = make([]int, 0, d.CellOffsets[numCells])
+	for i := range rings {
+		d.CellVertices = append(d.CellVertices, rings[i]...)
+		d.CellNeighbors = append(d.CellNeighbors, neighbors[i]...)
+	}
+	d.recordSites()
+	if err := d.checkStructure(); err != nil {
+		return nil, fmt.Errorf("FromRings: %w: %w", ErrInvalidRings, err)
+	}
+
+	for i := range numCells {
+		if d.ringTurn(i) > 0 {
+			continue
+		}
+		if !opts.FixOrientation {
+			return nil, fmt.Errorf("FromRings: %w: cell %d is not oriented like VertexIndices",
+				ErrInvalidRings, i)
+		}
+		d.reverseRing(i)
+	}
+	if err := d.checkRingEdges(); err != nil {
+		return nil, fmt.Errorf("FromRings: %w: %w", ErrInvalidRings, err)
+	}
+	res := d.checkEquidistance(CheckOptions{DistanceTolerance: opts.OverrideTolerance})
+	if !res.Passed {
+		return nil, fmt.Errorf("FromRings: %w: vertex %d is not shared by three cells whose "+
+			"sites are equidistant within %v", ErrInvalidRings, res.Offending[0],
+			opts.OverrideTolerance)
+	}
+	return d, nil
+}
+
+// ringTurn returns the sum of the orientations of the fan triangles from the site of cell i over
+// its ring edges, signed to be positive for a ring oriented like VertexIndices, which keeps the
+// site on the right of each edge.
+func (d *Diagram) ringTurn(i int) float64 {
+	site := d.Sites[i]
+	ring := Cell{idx: i, d: d}.VertexIndices()
+	turn := 0.0
+	for k, v := range ring {
+		a, b := d.Vertices[v], d.Vertices[ring[(k+1)%len(ring)]]
+		turn += site.Dot(b.Cross(a.Vector))
+	}
+	return turn
+}
+
+// reverseRing reverses the ring of cell i in place, keeping every neighbor against its edge.
+func (d *Diagram) reverseRing(i int) {
+	c := Cell{idx: i, d: d}
+	ring, neighbors := c.VertexIndices(), c.NeighborIndices()
+	n := len(ring)
+	slices.Reverse(ring)
+	// The edge from vertex k to k+1 of the reversed ring is the edge from n-2-k to n-1-k of
+	// the original ring, so the neighbors are reversed and rotated by one.
+	slices.Reverse(neighbors)
+	first := neighbors[0]
+	copy(neighbors, neighbors[1:])
+	neighbors[n-1] = first
+}
+
+// checkRingEdges verifies that the edge from vertex k to vertex k+1 of every cell is the edge
+// from vertex k+1 to vertex k of neighbor k, whose neighbor across it is the cell.
+func (d *Diagram) checkRingEdges() error {
+	type edgeSide struct {
+		cell, neighbor int
+	}
+	edges := make(map[[2]int]edgeSide, len(d.CellVertices))
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}
+		ring, neighbors := c.VertexIndices(), c.NeighborIndices()
+		for k, v := range ring {
+			e := [2]int{v, ring[(k+1)%len(ring)]}
+			if _, ok := edges[e]; ok {
+				return fmt.Errorf("cell %d: edge %v appears in more than one ring", i, e)
+			}
+			edges[e] = edgeSide{cell: i, neighbor: neighbors[k]}
+		}
+	}
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}
+		ring, neighbors := c.VertexIndices(), c.NeighborIndices()
+		for k, v := range ring {
+			e := [2]int{v, ring[(k+1)%len(ring)]}
+			other, ok := edges[[2]int{e[1], e[0]}]
+			if !ok || other.cell != neighbors[k] {
+				return fmt.Errorf("cell %d: edge %v is not shared with neighbor %d", i, e,
+					neighbors[k])
+			}
+			if other.neighbor != i {
+				return fmt.Errorf("cell %d: neighbor %d lists %d across edge %v", i,
+					neighbors[k], other.neighbor, e)
+			}
+		}
+	}
+	return nil
+}
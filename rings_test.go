@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Rings
+
+// cellRings returns copies of the rings and neighbors of every cell of d.
+func cellRings(d *Diagram) (rings, neighbors [][]int) {
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}
+		rings = append(rings, slices.Clone(c.VertexIndices()))
+		neighbors = append(neighbors, slices.Clone(c.NeighborIndices()))
+	}
+	return rings, neighbors
+}
+
+func TestFromRings(t *testing.T) {
+	want := mustNewDiagram(t, 200)
+	rings, neighbors := cellRings(want)
+	got, err := FromRings(want.Sites, want.Vertices, rings, neighbors)
+	if err != nil {
+		t.Fatalf("FromRings(...) error = %v, want nil", err)
+	}
+	for _, f := range []struct {
+		name      string
+		want, got any
+	}{
+		{"Sites", want.Sites, got.Sites},
+		{"Vertices", want.Vertices, got.Vertices},
+		{"CellVertices", want.CellVertices, got.CellVertices},
+		{"CellNeighbors", want.CellNeighbors, got.CellNeighbors},
+		{"CellOffsets", want.CellOffsets, got.CellOffsets},
+	} {
+		if diff := cmp.Diff(f.want, f.got); diff != "" {
+			t.Errorf("FromRings(...).%s mismatch (-want +got):\n%s", f.name, diff)
+		}
+	}
+	if r := Check(got); !r.OK() {
+		t.Errorf("Check(FromRings(...)) failed:\n%s", r)
+	}
+}
+
+func TestFromRings_FixOrientation(t *testing.T) {
+	want := mustNewDiagram(t, 50)
+	rings, neighbors := cellRings(want)
+	for _, i := range []int{0, 7, 31} {
+		n := len(rings[i])
+		slices.Reverse(rings[i])
+		// Keep each neighbor against its edge in the reversed ring.
+		slices.Reverse(neighbors[i])
+		neighbors[i] = append(neighbors[i][1:], neighbors[i][0])
+		if len(neighbors[i]) != n {
+			t.Fatalf("len(neighbors[%d]) = %d, want %d", i, len(neighbors[i]), n)
+		}
+	}
+
+	_, err := FromRings(want.Sites, want.Vertices, rings, neighbors)
+	if !errors.Is(err, ErrInvalidRings) {
+		t.Errorf("FromRings(clockwise) error = %v, want %v", err, ErrInvalidRings)
+	}
+	got, err := FromRings(want.Sites, want.Vertices, rings, neighbors, WithFixOrientation())
+	if err != nil {
+		t.Fatalf("FromRings(..., WithFixOrientation()) error = %v, want nil", err)
+	}
+	for i := range got.NumCells() {
+		a, b := Cell{idx: i, d: want}, Cell{idx: i, d: got}
+		if !slices.Equal(canonicalRing(a.VertexIndices()), canonicalRing(b.VertexIndices())) ||
+			!slices.Equal(canonicalRing(a.NeighborIndices()), canonicalRing(b.NeighborIndices())) {
+			t.Errorf("cell %d: ring %v neighbors %v, want rotation of %v %v", i,
+				b.VertexIndices(), b.NeighborIndices(), a.VertexIndices(), a.NeighborIndices())
+		}
+	}
+	if r := Check(got); !r.OK() {
+		t.Errorf("Check(FromRings(..., WithFixOrientation())) failed:\n%s", r)
+	}
+}
+
+func TestFromRings_Invalid(t *testing.T) {
+	src := mustNewDiagram(t, 50)
+	tests := []struct {
+		name   string
+		modify func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int)
+		wantErr error
+	}{
+		{"too few sites", func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int) {
+			return sites[:3], vertices, rings[:3], neighbors[:3]
+		}, ErrInsufficientSites},
+		{"missing ring", func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int) {
+			return sites, vertices, rings[1:], neighbors
+		}, ErrInvalidRings},
+		{"neighbor count", func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int) {
+			neighbors[4] = neighbors[4][1:]
+			return sites, vertices, rings, neighbors
+		}, ErrInvalidRings},
+		{"vertex out of range", func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int) {
+			rings[2][0] = len(vertices)
+			return sites, vertices, rings, neighbors
+		}, ErrOutOfRange},
+		{"asymmetric neighbor", func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int) {
+			neighbors[5][0], neighbors[5][1] = neighbors[5][1], neighbors[5][0]
+			return sites, vertices, rings, neighbors
+		}, ErrInvalidRings},
+		{"moved vertex", func(sites, vertices s2.PointVector, rings, neighbors [][]int) (
+			s2.PointVector, s2.PointVector, [][]int, [][]int) {
+			vertices[9] = s2.Point{Vector: vertices[9].Add(s2.Ortho(vertices[9]).Mul(1e-6)).
+				Normalize()}
+			return sites, vertices, rings, neighbors
+		}, ErrInvalidRings},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rings, neighbors := cellRings(src)
+			sites, vertices, rings, neighbors := tt.modify(slices.Clone(src.Sites),
+				slices.Clone(src.Vertices), rings, neighbors)
+			if _, err := FromRings(sites, vertices, rings, neighbors); !errors.Is(err,
+				tt.wantErr) {
+				t.Errorf("FromRings(...) error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+// ContourLines traces the iso-contours of the piecewise linear field given by one value per
+// vertex, one level at a time in the given order. Each contour crosses the triangle edges at
+// the point found by linear interpolation of the values along the edge, so contours of
+// adjacent triangles join exactly. A vertex whose value equals the level counts as below it.
+// Contours are oriented with values above the level on their left. A closed contour ends with
+// its first point repeated; open contours only arise in partial triangulations.
+// It returns an error if values does not have one value per vertex.
+func (t *Triangulation) ContourLines(values []float64, levels []float64) ([][]s2.Point,
+	error) {
+	if len(values) != len(t.Vertices) {
+		return nil, fmt.Errorf("ContourLines: got %d values for %d vertices", len(values),
+			len(t.Vertices))
+	}
+
+	var lines [][]s2.Point
+	for _, level := range levels {
+		lines = append(lines, t.contours(values, level)...)
+	}
+	return lines, nil
+}
+
+// contours returns the contours of the field at one level.
+func (t *Triangulation) contours(values []float64, level float64) [][]s2.Point {
+	above := func(v int) bool { return values[v] > level }
+
+	// Within a CCW triangle the contour runs from the edge leaving the region above the level
+	// to the edge entering it. next maps the first edge to the second, both as directed edges
+	// of the triangle; the neighbor continues from the reversed end edge.
+	next := make(map[[2]int][2]int)
+	var starts [][2]int
+	for _, tri := range t.Triangles {
+		var from, to [2]int
+		found := 0
+		for j := range 3 {
+			a, b := tri[j], tri[(j+1)%3]
+			switch {
+			case above(a) && !above(b):
+				from = [2]int{a, b}
+				found++
+			case !above(a) && above(b):
+				to = [2]int{a, b}
+				found++
+			}
+		}
+		if found == 2 {
+			next[from] = to
+			starts = append(starts, from)
+		}
+	}
+
+	crossing := func(e [2]int) s2.Point {
+		a, b := min(e[0], e[1]), max(e[0], e[1])
+		f := (level - values[a]) / (values[b] - values[a])
+		return s2.Interpolate(f, t.Vertices[a], t.Vertices[b])
+	}
+	reverse := func(e [2]int) [2]int { return [2]int{e[1], e[0]} }
+
+	// A segment begins an open contour when no segment ends on its start edge.
+	ends := make(map[[2]int]bool, len(next))
+	for _, to := range next {
+		ends[reverse(to)] = true
+	}
+	heads := make([][2]int, 0, len(starts))
+	for _, e := range starts {
+		if !ends[e] {
+			heads = append(heads, e)
+		}
+	}
+	heads = append(heads, starts...)
+
+	var lines [][]s2.Point
+	for _, head := range heads {
+		if _, ok := next[head]; !ok {
+			continue
+		}
+		line := []s2.Point{crossing(head)}
+		for e := head; ; {
+			to, ok := next[e]
+			if !ok {
+				break
+			}
+			delete(next, e)
+			line = append(line, crossing(to))
+			e = reverse(to)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Contour
+
+func TestContourLines_Equator(t *testing.T) {
+	dt := mustNewTriangulation(t, 2000)
+	values := make([]float64, len(dt.Vertices))
+	for i, p := range dt.Vertices {
+		values[i] = p.Z
+	}
+
+	lines, err := dt.ContourLines(values, []float64{0})
+	if err != nil {
+		t.Fatalf("dt.ContourLines(...) error = %v, want nil", err)
+	}
+	if len(lines) != 1 {
+		t.Fatalf("len(dt.ContourLines(...)) = %d, want 1", len(lines))
+	}
+	line := lines[0]
+	if line[0] != line[len(line)-1] {
+		t.Fatalf("contour is not closed: %v != %v", line[0], line[len(line)-1])
+	}
+
+	length := 0.0
+	for i := 1; i < len(line); i++ {
+		length += line[i-1].Distance(line[i]).Radians()
+		if math.Abs(line[i].Z) > 0.05 {
+			t.Errorf("contour point %v is far from the equator", line[i])
+		}
+	}
+	if math.Abs(length-2*math.Pi) > 0.02*2*math.Pi {
+		t.Errorf("contour length = %v, want ≈ %v", length, 2*math.Pi)
+	}
+
+	loop := s2.LoopFromPoints(line[:len(line)-1])
+	if !loop.ContainsPoint(s2.PointFromCoords(0, 0, 1)) {
+		t.Errorf("contour does not keep the values above the level on its left")
+	}
+}
+
+func TestContourLines_Levels(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	values := make([]float64, len(dt.Vertices))
+	for i, p := range dt.Vertices {
+		values[i] = p.Z
+	}
+
+	tests := []struct {
+		name   string
+		levels []float64
+		want   int
+	}{
+		{"none", nil, 0},
+		{"two", []float64{-0.5, 0.5}, 2},
+		{"out of range", []float64{2}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := dt.ContourLines(values, tt.levels)
+			if err != nil {
+				t.Fatalf("dt.ContourLines(...) error = %v, want nil", err)
+			}
+			if len(lines) != tt.want {
+				t.Errorf("len(dt.ContourLines(%v)) = %d, want %d", tt.levels, len(lines), tt.want)
+			}
+		})
+	}
+
+	if _, err := dt.ContourLines(values[1:], []float64{0}); err == nil {
+		t.Errorf("dt.ContourLines(short values) error = nil, want non-nil")
+	}
+}
+
+func TestContourLines_SharedCrossings(t *testing.T) {
+	dt, err := NewTriangulation(utils.GenerateRandomPoints(500, 3))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	values := make([]float64, len(dt.Vertices))
+	for i, p := range dt.Vertices {
+		values[i] = math.Sin(3*p.X) + p.Y*p.Z
+	}
+
+	lines, err := dt.ContourLines(values, []float64{-0.3, 0, 0.3})
+	if err != nil {
+		t.Fatalf("dt.ContourLines(...) error = %v, want nil", err)
+	}
+	seen := make(map[s2.Point]bool)
+	for i, line := range lines {
+		if line[0] != line[len(line)-1] {
+			t.Errorf("contour %d is not closed", i)
+		}
+		for _, p := range line[1:] {
+			if seen[p] {
+				t.Errorf("contour %d revisits %v", i, p)
+			}
+			seen[p] = true
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// duplicateTolerance is the angle within which NewTriangulation reports input vertices as
+// coincident in a *DuplicateError. It is independent of Eps, which is a relative distance to
+// the hull planes rather than an angle between vertices.
+const duplicateTolerance = s1.Angle(1e-12)
+
+// WithDeduplication makes NewTriangulation merge every input vertex within tolerance of an
+// earlier kept vertex into it before triangulating, so that repeated or snapped coordinates
+// are accepted. A tolerance of 0 merges exact duplicates only. Kept vertices stay in input
+// order at the position of their first occurrence, and SourceIndex maps every input vertex to
+// the vertex it was merged into. The triangulation then stores its own copy of the kept
+// vertices even under WithBorrowInput.
+// The tolerance must not be negative.
+func WithDeduplication(tolerance s1.Angle) TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		if tolerance < 0 {
+			return &OptionError{Name: "WithDeduplication",
+				Err: fmt.Errorf("%w: tolerance must not be negative got %v",
+					ErrInvalidOption, tolerance)}
+		}
+		o.Deduplicate = true
+		o.DeduplicationTolerance = tolerance
+		return nil
+	}
+}
+
+// mergeVertices returns the vertices kept by clusterVertices and, for each input vertex, the
+// index among them of the vertex it was merged into.
+func mergeVertices(vertices s2.PointVector, tolerance s1.Angle) (s2.PointVector, []int) {
+	rep := clusterVertices(vertices, tolerance)
+	kept := make(s2.PointVector, 0, len(vertices))
+	source := make([]int, len(vertices))
+	for i, r := range rep {
+		if r == i {
+			source[i] = len(kept)
+			kept = append(kept, vertices[i])
+		} else {
+			source[i] = source[r]
+		}
+	}
+	return kept, source
+}
+
+// duplicatePairs returns the pairs of input indices merged by clusterVertices, each as the
+// kept vertex and the duplicate, in ascending order of the duplicate.
+func duplicatePairs(vertices s2.PointVector, tolerance s1.Angle) [][2]int {
+	var pairs [][2]int
+	for i, r := range clusterVertices(vertices, tolerance) {
+		if r != i {
+			pairs = append(pairs, [2]int{r, i})
+		}
+	}
+	return pairs
+}
+
+// clusterVertices returns for each vertex the index of the first earlier vertex within
+// tolerance of it that is itself kept, or its own index if there is none.
+// Kept vertices are bucketed by the S2 cell at the deepest level whose cells are at least
+// tolerance wide, so a vertex within tolerance of another lies in its cell or a neighbor.
+func clusterVertices(vertices s2.PointVector, tolerance s1.Angle) []int {
+	rep := make([]int, len(vertices))
+	if float64(tolerance) >= s2.MinWidthMetric.Value(0) {
+		for i, p := range vertices {
+			rep[i] = i
+			for j := range i {
+				if rep[j] == j && p.Distance(vertices[j]) <= tolerance {
+					rep[i] = j
+					break
+				}
+			}
+		}
+		return rep
+	}
+
+	level := s2.MinWidthMetric.MaxLevel(float64(tolerance))
+	buckets := make(map[s2.CellID][]int)
+	for i, p := range vertices {
+		rep[i] = i
+		id := s2.CellFromPoint(p).ID().Parent(level)
+	search:
+		for _, c := range append(id.AllNeighbors(level), id) {
+			for _, j := range buckets[c] {
+				if p.Distance(vertices[j]) <= tolerance {
+					rep[i] = j
+					break search
+				}
+			}
+		}
+		if rep[i] == i {
+			buckets[id] = append(buckets[id], i)
+		}
+	}
+	return rep
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Deduplication
+
+// withDuplicates returns 100 random points followed by copies of points 3, 40 and 3 again, the
+// last one moved by sep radians.
+func withDuplicates(sep s1.Angle) s2.PointVector {
+	points := utils.GenerateRandomPoints(100, 0)
+	moved := s2.InterpolateAtDistance(sep, points[3], points[4])
+	return append(points, points[3], points[40], moved)
+}
+
+func TestWithDeduplication(t *testing.T) {
+	tests := []struct {
+		name      string
+		tolerance s1.Angle
+		sep       s1.Angle
+		wantKept  int
+		wantLast  int
+	}{
+		{"exact", 0, 0, 100, 3},
+		{"exact with near duplicate", 0, 1e-9, 101, 100},
+		{"near duplicate within tolerance", 1e-8, 1e-9, 100, 3},
+		{"near duplicate beyond tolerance", 1e-10, 1e-9, 101, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := withDuplicates(tt.sep)
+			dt, err := NewTriangulation(points, WithDeduplication(tt.tolerance),
+				WithBorrowInput())
+			if err != nil {
+				t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+			}
+			if len(dt.Vertices) != tt.wantKept {
+				t.Fatalf("len(dt.Vertices) = %d, want %d", len(dt.Vertices), tt.wantKept)
+			}
+			if len(dt.SourceIndex) != len(points) {
+				t.Fatalf("len(dt.SourceIndex) = %d, want %d", len(dt.SourceIndex), len(points))
+			}
+			want := make([]int, len(points))
+			for i := range 100 {
+				want[i] = i
+			}
+			want[100], want[101], want[102] = 3, 40, tt.wantLast
+			if !slices.Equal(dt.SourceIndex, want) {
+				t.Errorf("dt.SourceIndex[100:] = %v, want %v", dt.SourceIndex[100:], want[100:])
+			}
+			for i, v := range dt.SourceIndex {
+				if d := points[i].Distance(dt.Vertices[v]); d > tt.tolerance {
+					t.Errorf("input %d merged into vertex %d at %v, want within %v", i, v, d,
+						tt.tolerance)
+				}
+			}
+			if err := dt.checkStructure(); err != nil {
+				t.Errorf("dt.checkStructure() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestWithDeduplication_Large(t *testing.T) {
+	// A tolerance wider than a cube face compares every pair of vertices.
+	points := utils.GenerateRandomPoints(50, 1)
+	dt, err := NewTriangulation(points, WithDeduplication(1.2))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	for i, p := range dt.Vertices {
+		for j := range i {
+			if p.Distance(dt.Vertices[j]) <= 1.2 {
+				t.Errorf("kept vertices %d and %d are within the tolerance", j, i)
+			}
+		}
+	}
+}
+
+func TestNewTriangulation_Duplicates(t *testing.T) {
+	_, err := NewTriangulation(withDuplicates(0))
+	var de *DuplicateError
+	if !errors.As(err, &de) {
+		t.Fatalf("NewTriangulation(duplicates) error = %v, want DuplicateError", err)
+	}
+	want := [][2]int{{3, 100}, {40, 101}, {3, 102}}
+	if !slices.Equal(de.Pairs, want) {
+		t.Errorf("de.Pairs = %v, want %v", de.Pairs, want)
+	}
+	if !errors.Is(err, ErrInvalidHull) {
+		t.Errorf("errors.Is(%v, ErrInvalidHull) = false, want true", err)
+	}
+
+	// Duplicates are found by angle, whatever the hull epsilon.
+	_, err = NewTriangulation(withDuplicates(1e-13), WithEps(1e-17))
+	if !errors.As(err, &de) || !slices.Equal(de.Pairs, want) {
+		t.Errorf("NewTriangulation(near duplicates, WithEps(1e-17)) error = %v, want pairs %v",
+			err, want)
+	}
+
+	dt := mustNewTriangulation(t, 10)
+	if dt.SourceIndex != nil {
+		t.Errorf("dt.SourceIndex = %v without WithDeduplication, want nil", dt.SourceIndex)
+	}
+}
+
+func TestRemovePoint_SourceIndex(t *testing.T) {
+	dt, err := NewTriangulation(withDuplicates(0), WithDeduplication(0))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	if err := dt.RemovePoint(3); err != nil {
+		t.Fatalf("dt.RemovePoint(3) error = %v, want nil", err)
+	}
+	if got := dt.SourceIndex[3]; got != -1 {
+		t.Errorf("dt.SourceIndex[3] = %d after removal, want -1", got)
+	}
+	if got := dt.SourceIndex[101]; got != 39 {
+		t.Errorf("dt.SourceIndex[101] = %d after removal, want 39", got)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// DumpInternals writes a canonical, human-readable description of the triangulation topology
+// to w, suitable for diffing against a golden file. It lists the vertex and triangle counts,
+// the incidence offsets, every triangle as its vertices rotated to start at the smallest, in
+// lexicographic order, and the CCW ring of neighbors of every vertex rotated to start at the
+// smallest. The dump does not depend on the order of Triangles, so it is stable across hull
+// implementations that enumerate the same triangles differently. Coordinates are not included.
+// It returns an error if writing to w fails, or wrapping ErrInvalidMesh if an incident
+// triangle of a vertex does not contain it.
+func (t *Triangulation) DumpInternals(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "vertices %d\n", len(t.Vertices))
+	fmt.Fprintf(bw, "triangles %d\n", len(t.Triangles))
+	fmt.Fprintf(bw, "offsets%s\n", formatInts(t.IncidentTriangleOffsets))
+
+	tris := make([][]int, len(t.Triangles))
+	for i, tri := range t.Triangles {
+		tris[i] = rotateToMin(tri[:])
+	}
+	slices.SortFunc(tris, slices.Compare)
+	for _, tri := range tris {
+		fmt.Fprintf(bw, "triangle%s\n", formatInts(tri))
+	}
+	for v := range t.Vertices {
+		ring, err := t.vertexNeighbors(v)
+		if err != nil {
+			return fmt.Errorf("DumpInternals: %w", err)
+		}
+		fmt.Fprintf(bw, "vertex %d neighbors%s\n", v, formatInts(rotateToMin(ring)))
+	}
+	return bw.Flush()
+}
+
+// rotateToMin returns a copy of the cyclic ring rotated to start at its smallest element.
+func rotateToMin(ring []int) []int {
+	if len(ring) == 0 {
+		return nil
+	}
+	start := 0
+	for i, v := range ring {
+		if v < ring[start] {
+			start = i
+		}
+	}
+	out := make([]int, 0, len(ring))
+	out = append(out, ring[start:]...)
+	return append(out, ring[:start]...)
+}
+
+// formatInts formats the values, each preceded by a space.
+func formatInts(values []int) string {
+	var b []byte
+	for _, v := range values {
+		b = fmt.Appendf(b, " %d", v)
+	}
+	return string(b)
+}
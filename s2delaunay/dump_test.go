@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Dump
+
+func TestDumpInternals_Tetrahedron(t *testing.T) {
+	want := `vertices 4
+triangles 4
+offsets 0 3 6 9 12
+triangle 0 1 2
+triangle 0 2 3
+triangle 0 3 1
+triangle 1 3 2
+vertex 0 neighbors 1 3 2
+vertex 1 neighbors 0 2 3
+vertex 2 neighbors 0 3 1
+vertex 3 neighbors 0 1 2
+`
+	var buf bytes.Buffer
+	if err := mustNewTetrahedron(t).DumpInternals(&buf); err != nil {
+		t.Fatalf("dt.DumpInternals(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("dt.DumpInternals(...) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDumpInternals_TriangleOrder(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	shuffled := make([]Triangle, len(dt.Triangles))
+	copy(shuffled, dt.Triangles)
+	rng := rand.New(rand.NewSource(0))
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	for i := range shuffled {
+		// Rotate the vertices without changing the orientation.
+		shuffled[i] = [3]int{shuffled[i][1], shuffled[i][2], shuffled[i][0]}
+	}
+	other, err := FromMesh(dt.Vertices, shuffled)
+	if err != nil {
+		t.Fatalf("FromMesh(...) error = %v, want nil", err)
+	}
+
+	var want, got bytes.Buffer
+	if err := dt.DumpInternals(&want); err != nil {
+		t.Fatalf("dt.DumpInternals(...) error = %v, want nil", err)
+	}
+	if err := other.DumpInternals(&got); err != nil {
+		t.Fatalf("other.DumpInternals(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want.String(), got.String()); diff != "" {
+		t.Errorf("other.DumpInternals(...) mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Edges returns the unique undirected edges of the triangulation as vertex index pairs with
+// e[0] < e[1], sorted lexicographically. For a complete triangulation there are 3T/2 edges for
+// T triangles. The list is built on first use and shared by later calls, so it must not be
+// modified.
+func (t *Triangulation) Edges() [][2]int {
+	t.edgesOnce.Do(func() {
+		edges := make([][2]int, 0, 3*len(t.Triangles))
+		for _, tri := range t.Triangles {
+			for j := range 3 {
+				a, b := tri[j], tri[(j+1)%3]
+				edges = append(edges, [2]int{min(a, b), max(a, b)})
+			}
+		}
+		slices.SortFunc(edges, func(x, y [2]int) int {
+			if x[0] != y[0] {
+				return x[0] - y[0]
+			}
+			return x[1] - y[1]
+		})
+		t.edges = slices.Clip(slices.Compact(edges))
+	})
+	return t.edges
+}
+
+// ForEachEdge calls fn once for each undirected edge of a complete triangulation with a < b,
+// in triangle order rather than sorted like Edges, and without allocating. Each edge is
+// reported by the triangle in which b follows a CCW, so edges on the boundary of a partial
+// triangulation may be skipped.
+func (t *Triangulation) ForEachEdge(fn func(a, b int)) {
+	for _, tri := range t.Triangles {
+		for j := range 3 {
+			if a, b := tri[j], tri[(j+1)%3]; a < b {
+				fn(a, b)
+			}
+		}
+	}
+}
+
+// IncidentEdges returns the edges joining the vertex at the given index to its neighbors, each
+// as [2]int{vIdx, neighbor} with the neighbors in IncidentVertices order, so edge k is the one
+// leaving the vertex in incident triangle k toward its NextVertex. It walks the sorted
+// incidence arrays like IncidentVertices, without the edge list of Edges.
+// It returns an error if the vertex index is out of range.
+func (t *Triangulation) IncidentEdges(vIdx int) ([][2]int, error) {
+	if vIdx < 0 || vIdx+1 >= len(t.IncidentTriangleOffsets) {
+		return nil, fmt.Errorf("IncidentEdges: vIdx %d %w [0 %d)", vIdx, ErrOutOfRange,
+			len(t.IncidentTriangleOffsets)-1)
+	}
+	incident, _ := t.IncidentTriangles(vIdx)
+	edges := make([][2]int, len(incident))
+	for k, tIdx := range incident {
+		edges[k] = [2]int{vIdx, t.Triangles[tIdx].NextVertex(vIdx)}
+	}
+	return edges, nil
+}
+
+// EdgeTriangles returns the two triangles flanking the edge between vertices e[0] and e[1],
+// in either order: first the triangle on the left of the edge directed from e[0] to e[1] when
+// looking out of the sphere, in which e[1] follows e[0] CCW, then the triangle on its right.
+// It returns an error if a vertex index is out of range or the vertices are not joined by an
+// edge with a triangle on each side, which can happen on a partial triangulation.
+func (t *Triangulation) EdgeTriangles(e [2]int) ([2]int, error) {
+	for _, v := range e {
+		if v < 0 || v+1 >= len(t.IncidentTriangleOffsets) {
+			return [2]int{}, fmt.Errorf("EdgeTriangles: vertex %d %w [0 %d)", v, ErrOutOfRange,
+				len(t.IncidentTriangleOffsets)-1)
+		}
+	}
+	out := [2]int{-1, -1}
+	incident, _ := t.IncidentTriangles(e[0])
+	for _, tIdx := range incident {
+		tri := t.Triangles[tIdx]
+		if tri.NextVertex(e[0]) == e[1] {
+			out[0] = tIdx
+		}
+		if tri.PrevVertex(e[0]) == e[1] {
+			out[1] = tIdx
+		}
+	}
+	if out[0] < 0 || out[1] < 0 {
+		return [2]int{}, fmt.Errorf("EdgeTriangles: edge %v %w", e, ErrNotFound)
+	}
+	return out, nil
+}
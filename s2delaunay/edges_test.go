@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Edges
+
+func TestTriangulation_Edges(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	edges := dt.Edges()
+	if got, want := len(edges), 3*len(dt.Triangles)/2; got != want {
+		t.Fatalf("len(dt.Edges()) = %d, want 3T/2 = %d", got, want)
+	}
+	for i, e := range edges {
+		if e[0] >= e[1] {
+			t.Errorf("dt.Edges()[%d] = %v, want e[0] < e[1]", i, e)
+		}
+		if i > 0 {
+			prev := edges[i-1]
+			if prev[0] > e[0] || (prev[0] == e[0] && prev[1] >= e[1]) {
+				t.Fatalf("dt.Edges()[%d] = %v follows %v, want strictly sorted", i, e, prev)
+			}
+		}
+	}
+	if &dt.Edges()[0] != &edges[0] {
+		t.Errorf("dt.Edges() rebuilt on the second call, want cached")
+	}
+
+	if _, err := dt.SplitEdge(edges[0][0], edges[0][1], 0.5); err != nil {
+		t.Fatalf("dt.SplitEdge(...) error = %v, want nil", err)
+	}
+	if got, want := len(dt.Edges()), 3*len(dt.Triangles)/2; got != want {
+		t.Errorf("len(dt.Edges()) after SplitEdge = %d, want %d", got, want)
+	}
+}
+
+func TestTriangulation_ForEachEdge(t *testing.T) {
+	dt := mustNewTriangulation(t, 500)
+	var got [][2]int
+	dt.ForEachEdge(func(a, b int) {
+		got = append(got, [2]int{a, b})
+	})
+	if want := 3*len(dt.Vertices) - 6; len(got) != want {
+		t.Fatalf("dt.ForEachEdge(...) visited %d edges, want 3N-6 = %d", len(got), want)
+	}
+	slices.SortFunc(got, func(x, y [2]int) int {
+		if x[0] != y[0] {
+			return x[0] - y[0]
+		}
+		return x[1] - y[1]
+	})
+	if !slices.Equal(got, dt.Edges()) {
+		t.Errorf("dt.ForEachEdge(...) edges differ from dt.Edges()")
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dt.ForEachEdge(func(int, int) {})
+	})
+	if allocs != 0 {
+		t.Errorf("dt.ForEachEdge(...) allocs = %v, want 0", allocs)
+	}
+}
+
+func TestTriangulation_EdgeTriangles(t *testing.T) {
+	dt := mustNewTriangulation(t, 200)
+	for _, e := range dt.Edges() {
+		for _, dir := range [][2]int{e, {e[1], e[0]}} {
+			got, err := dt.EdgeTriangles(dir)
+			if err != nil {
+				t.Fatalf("dt.EdgeTriangles(%v) error = %v, want nil", dir, err)
+			}
+			left, right := dt.Triangles[got[0]], dt.Triangles[got[1]]
+			if next, _ := NextVertex(left, dir[0]); next != dir[1] {
+				t.Errorf("dt.EdgeTriangles(%v)[0] = %v, want %d after %d", dir, left, dir[1],
+					dir[0])
+			}
+			if prev, _ := PrevVertex(right, dir[0]); prev != dir[1] {
+				t.Errorf("dt.EdgeTriangles(%v)[1] = %v, want %d before %d", dir, right, dir[1],
+					dir[0])
+			}
+		}
+	}
+}
+
+func TestTriangulation_EdgeTriangles_Invalid(t *testing.T) {
+	dt := mustNewTetrahedron(t)
+	if got := len(dt.Edges()); got != 6 {
+		t.Errorf("len(dt.Edges()) = %d, want 6", got)
+	}
+	tests := []struct {
+		name string
+		e    [2]int
+		want error
+	}{
+		{"negative", [2]int{-1, 0}, ErrOutOfRange},
+		{"too large", [2]int{0, 4}, ErrOutOfRange},
+		{"loop", [2]int{1, 1}, ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := dt.EdgeTriangles(tt.e); !errors.Is(err, tt.want) {
+				t.Errorf("dt.EdgeTriangles(%v) error = %v, want %v", tt.e, err, tt.want)
+			}
+		})
+	}
+
+	dt = mustNewTriangulation(t, 100)
+	for v := 1; v < len(dt.Vertices); v++ {
+		if slices.Contains(dt.Edges(), [2]int{0, v}) {
+			continue
+		}
+		if _, err := dt.EdgeTriangles([2]int{0, v}); !errors.Is(err, ErrNotFound) {
+			t.Errorf("dt.EdgeTriangles({0 %d}) error = %v, want ErrNotFound", v, err)
+		}
+		break
+	}
+}
+
+func TestTriangulation_IncidentEdges(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	edges := dt.Edges()
+	for v := range dt.Vertices {
+		got, err := dt.IncidentEdges(v)
+		if err != nil {
+			t.Fatalf("dt.IncidentEdges(%d) error = %v, want nil", v, err)
+		}
+		neighbors, _ := dt.IncidentVertices(v)
+		if len(got) != len(neighbors) {
+			t.Fatalf("len(dt.IncidentEdges(%d)) = %d, want %d", v, len(got), len(neighbors))
+		}
+		for k, e := range got {
+			if e != [2]int{v, neighbors[k]} {
+				t.Errorf("dt.IncidentEdges(%d)[%d] = %v, want %v", v, k, e,
+					[2]int{v, neighbors[k]})
+			}
+			if !slices.Contains(edges, [2]int{min(e[0], e[1]), max(e[0], e[1])}) {
+				t.Errorf("dt.IncidentEdges(%d)[%d] = %v, not in dt.Edges()", v, k, e)
+			}
+		}
+	}
+	for _, vIdx := range []int{-1, len(dt.Vertices)} {
+		if _, err := dt.IncidentEdges(vIdx); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.IncidentEdges(%d) error = %v, want ErrOutOfRange", vIdx, err)
+		}
+	}
+}
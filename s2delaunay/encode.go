@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/2dChan/s2voronoi/internal/wire"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	triangulationMagic   = 0x54443253 // "S2DT"
+	triangulationVersion = 1
+
+	// unitNormTolerance bounds the deviation from unit norm accepted for input and decoded
+	// vertices. It is independent of Eps, which bounds hull coplanarity instead.
+	unitNormTolerance = 1e-9
+)
+
+// MarshalBinary encodes the triangulation in a compact little-endian binary format.
+// It implements encoding.BinaryMarshaler.
+func (t *Triangulation) MarshalBinary() ([]byte, error) {
+	var w wire.Writer
+	w.Uint32(triangulationMagic)
+	w.Uint32(triangulationVersion)
+	w.Uint32(uint32(t.idLevel))
+	w.Points(t.Vertices)
+	flat := make([]int, 0, 3*len(t.Triangles))
+	for _, tri := range t.Triangles {
+		flat = append(flat, tri[:]...)
+	}
+	for _, s := range [][]int{flat, t.IncidentTriangleIndices, t.IncidentTriangleOffsets} {
+		if err := w.Ints(s); err != nil {
+			return nil, fmt.Errorf("MarshalBinary: %w", err)
+		}
+	}
+	return w.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a triangulation produced by MarshalBinary, replacing the contents of
+// t. The decoded arrays are checked for structural consistency.
+// It implements encoding.BinaryUnmarshaler and returns an error wrapping ErrInvalidEncoding if
+// the data is malformed.
+func (t *Triangulation) UnmarshalBinary(data []byte) error {
+	r := wire.NewReader(data)
+	if magic, version := r.Uint32(), r.Uint32(); magic != triangulationMagic ||
+		version != triangulationVersion {
+		return fmt.Errorf("UnmarshalBinary: %w: bad magic %#x or version %d", ErrInvalidEncoding,
+			magic, version)
+	}
+	idLevel := int(r.Uint32())
+	vertices := r.Points()
+	flat := r.Ints()
+	indices := r.Ints()
+	offsets := r.Ints()
+	if err := r.Close(); err != nil {
+		return fmt.Errorf("UnmarshalBinary: %w: %w", ErrInvalidEncoding, err)
+	}
+	if len(flat)%3 != 0 {
+		return fmt.Errorf("UnmarshalBinary: %w: %d triangle indices", ErrInvalidEncoding, len(flat))
+	}
+	triangles := make([]Triangle, len(flat)/3)
+	for i := range triangles {
+		triangles[i] = [3]int{flat[3*i], flat[3*i+1], flat[3*i+2]}
+	}
+	if idLevel > s2.MaxLevel {
+		return fmt.Errorf("UnmarshalBinary: %w: id level %d", ErrInvalidEncoding, idLevel)
+	}
+
+	*t = Triangulation{
+		Vertices:                vertices,
+		Triangles:               triangles,
+		IncidentTriangleIndices: indices,
+		IncidentTriangleOffsets: offsets,
+		idLevel:                 idLevel,
+	}
+	if err := t.checkStructure(); err != nil {
+		return fmt.Errorf("UnmarshalBinary: %w: %w", ErrInvalidEncoding, err)
+	}
+	t.recordVertices()
+	return nil
+}
+
+// checkStructure verifies that the arrays of the triangulation are mutually consistent: array
+// sizes, index bounds, CSR offsets, incidence and unit-norm vertices.
+func (t *Triangulation) checkStructure() error {
+	numVertices := len(t.Vertices)
+	if numVertices < 4 {
+		return ErrInsufficientVertices
+	}
+	numTriangles := 2 * (numVertices - 2)
+	if len(t.Triangles) != numTriangles || len(t.IncidentTriangleIndices) != 3*numTriangles ||
+		len(t.IncidentTriangleOffsets) != numVertices+1 {
+		return fmt.Errorf("array sizes %d, %d, %d do not match %d vertices", len(t.Triangles),
+			len(t.IncidentTriangleIndices), len(t.IncidentTriangleOffsets), numVertices)
+	}
+	for i, p := range t.Vertices {
+		if n := p.Norm(); math.IsNaN(n) || math.Abs(n-1) > unitNormTolerance {
+			return fmt.Errorf("vertex %d norm %v is not unit", i, n)
+		}
+	}
+	for i, tri := range t.Triangles {
+		for _, v := range tri {
+			if v < 0 || v >= numVertices {
+				return fmt.Errorf("triangle %d vertex %d %w [0 %d)", i, v, ErrOutOfRange,
+					numVertices)
+			}
+		}
+	}
+	if t.IncidentTriangleOffsets[0] != 0 ||
+		t.IncidentTriangleOffsets[numVertices] != len(t.IncidentTriangleIndices) {
+		return fmt.Errorf("incident offsets do not span %d indices", len(t.IncidentTriangleIndices))
+	}
+	for v := range numVertices {
+		start, end := t.IncidentTriangleOffsets[v], t.IncidentTriangleOffsets[v+1]
+		if start > end {
+			return fmt.Errorf("incident offsets decrease at vertex %d", v)
+		}
+		for _, tIdx := range t.IncidentTriangleIndices[start:end] {
+			if tIdx < 0 || tIdx >= numTriangles {
+				return fmt.Errorf("incident triangle %d %w [0 %d)", tIdx, ErrOutOfRange,
+					numTriangles)
+			}
+			if _, err := NextVertex(t.Triangles[tIdx], v); err != nil {
+				return fmt.Errorf("vertex %d: incident triangle %d: %w", v, tIdx, err)
+			}
+		}
+	}
+	return nil
+}
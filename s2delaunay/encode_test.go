@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// Encoding
+
+func TestTriangulation_MarshalBinary(t *testing.T) {
+	want := mustNewTriangulation(t, 100)
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("want.MarshalBinary() error = %v, want nil", err)
+	}
+	got := new(Triangulation)
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("got.UnmarshalBinary(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(Triangulation{})); diff != "" {
+		t.Errorf("UnmarshalBinary(MarshalBinary()) mismatch (-want +got):\n%s", diff)
+	}
+	if got.TriangleID(0) != want.TriangleID(0) {
+		t.Errorf("got.TriangleID(0) = %#x, want %#x", got.TriangleID(0), want.TriangleID(0))
+	}
+}
+
+func TestTriangulation_UnmarshalBinary_Invalid(t *testing.T) {
+	dt := mustNewTriangulation(t, 10)
+	dt.IncidentTriangleIndices[3] = len(dt.Triangles)
+	inconsistent, err := dt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("dt.MarshalBinary() error = %v, want nil", err)
+	}
+	valid, err := mustNewTriangulation(t, 10).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated", valid[:len(valid)-1]},
+		{"trailing", append(valid[:len(valid):len(valid)], 0)},
+		{"inconsistent", inconsistent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := new(Triangulation).UnmarshalBinary(tt.data)
+			if !errors.Is(err, ErrInvalidEncoding) {
+				t.Errorf("UnmarshalBinary(...) error = %v, want ErrInvalidEncoding", err)
+			}
+		})
+	}
+}
+
+func TestTriangulation_UnmarshalText_Invalid(t *testing.T) {
+	valid, err := mustNewTriangulation(t, 10).MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v, want nil", err)
+	}
+	lines := strings.SplitAfter(string(valid), "\n")
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"truncated", strings.Join(lines[:len(lines)-2], "")},
+		{"trailing", string(valid) + "t 0 1 2\n"},
+		{"bad version", strings.Replace(string(valid), "triangulation 1", "triangulation 2", 1)},
+		{"out of range", strings.Replace(string(valid), "\nt 0", "\nt 10", 1)},
+		{"short triangle", strings.Replace(string(valid), "\nt 0 ", "\nt ", 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := new(Triangulation).UnmarshalText([]byte(tt.data))
+			if !errors.Is(err, ErrInvalidEncoding) {
+				t.Errorf("UnmarshalText(...) error = %v, want ErrInvalidEncoding", err)
+			}
+		})
+	}
+}
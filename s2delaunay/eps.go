@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+const (
+	minAutoEps = 1e-15
+	maxAutoEps = 1e-9
+)
+
+// AutoEps estimates a hull epsilon suited to the spacing of the vertices.
+//
+// The vertices are ordered along the S2 Hilbert curve and the smallest nonzero chord distance d
+// between consecutive vertices is taken as the spacing scale, which costs O(n log n) instead of
+// comparing all pairs. In the worst case a vertex d away from its neighbors rises only about
+// d²/8 above the plane through them, so the epsilon is d²/16 clamped to [1e-15, 1e-9]: small enough to keep distinct
+// vertices on the hull, large enough to stay above floating-point noise.
+func AutoEps(vertices s2.PointVector) float64 {
+	if len(vertices) < 2 {
+		return maxAutoEps
+	}
+
+	order := make([]int, len(vertices))
+	ids := make([]s2.CellID, len(vertices))
+	for i, p := range vertices {
+		order[i] = i
+		ids[i] = s2.CellFromPoint(p).ID()
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		switch {
+		case ids[a] < ids[b]:
+			return -1
+		case ids[a] > ids[b]:
+			return 1
+		}
+		return 0
+	})
+
+	minChord2 := 0.0
+	for i := 1; i < len(order); i++ {
+		d2 := vertices[order[i]].Sub(vertices[order[i-1]].Vector).Norm2()
+		if d2 > 0 && (minChord2 == 0 || d2 < minChord2) {
+			minChord2 = d2
+		}
+	}
+	if minChord2 == 0 {
+		return maxAutoEps
+	}
+
+	return min(max(minChord2/16, minAutoEps), maxAutoEps)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// AutoEps
+
+func TestAutoEps(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices s2.PointVector
+		want     float64
+	}{
+		{"empty", nil, maxAutoEps},
+		{"coincident", s2.PointVector{s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(1, 0, 0)},
+			maxAutoEps},
+		{"sparse", s2.PointVector{s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(0, 1, 0)},
+			maxAutoEps},
+		{"dense", s2.PointVector{
+			s2.PointFromCoords(1, 0, 0),
+			s2.PointFromCoords(1, 1e-5, 0),
+		}, 1e-10 / 16},
+		{"tiny", s2.PointVector{
+			s2.PointFromCoords(1, 0, 0),
+			s2.PointFromCoords(1, 1e-12, 0),
+		}, minAutoEps},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AutoEps(tt.vertices)
+			if got < tt.want*0.99 || got > tt.want*1.01 {
+				t.Errorf("AutoEps(...) = %v, want ~%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithAutoEps(t *testing.T) {
+	opts := &TriangulationOptions{Eps: defaultEps}
+	if err := WithAutoEps()(opts); err != nil || !opts.AutoEps {
+		t.Errorf("WithAutoEps() error = %v, opts.AutoEps = %v, want nil, true", err, opts.AutoEps)
+	}
+	if err := WithEps(0.5)(opts); err != nil || opts.AutoEps {
+		t.Errorf("WithEps(0.5) error = %v, opts.AutoEps = %v, want nil, false", err, opts.AutoEps)
+	}
+}
+
+func TestNewTriangulation_WithAutoEps(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(100, 0)
+	near := s2.Point{Vector: vertices[0].Add(s2.Ortho(vertices[0]).Mul(1e-13)).Normalize()}
+	vertices = append(vertices, near)
+
+	if _, err := NewTriangulation(vertices); err == nil {
+		t.Fatalf("NewTriangulation(...) error = nil, want non-nil with default eps")
+	}
+	dt, err := NewTriangulation(vertices, WithAutoEps())
+	if err != nil {
+		t.Fatalf("NewTriangulation(..., WithAutoEps()) error = %v, want nil", err)
+	}
+	if got, want := len(dt.Triangles), 2*(len(vertices)-2); got != want {
+		t.Errorf("len(dt.Triangles) = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+// Sentinel errors wrapped by the errors returned from this package. Test for them with
+// errors.Is.
+var (
+	// ErrInsufficientVertices reports that fewer than 4 vertices were given.
+	ErrInsufficientVertices = errors.New("insufficient vertices for triangulation minimum 4 required")
+	// ErrInvalidHull reports a convex hull that cannot be turned into a triangulation, such as
+	// the hull of coplanar or degenerate vertices.
+	ErrInvalidHull = errors.New("invalid convex hull")
+	// ErrInvalidOption reports an option with an invalid value.
+	ErrInvalidOption = errors.New("invalid option")
+	// ErrOutOfRange reports an index outside the valid range.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrNotInTriangle reports a vertex that is not part of the given triangle.
+	ErrNotInTriangle = errors.New("not in triangle")
+	// ErrNotFound reports a lookup for an ID that does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrIDCollision reports two elements sharing a stable ID.
+	ErrIDCollision = errors.New("id collision")
+	// ErrInvalidMesh reports triangles that do not form a closed, manifold, consistently
+	// oriented triangulation of the sphere.
+	ErrInvalidMesh = errors.New("invalid mesh")
+	// ErrNotDelaunay reports an edge whose opposite vertex lies inside the circumcap of the
+	// triangle across it.
+	ErrNotDelaunay = errors.New("not delaunay")
+	// ErrInvalidEncoding reports serialized data that is malformed or inconsistent.
+	ErrInvalidEncoding = errors.New("invalid encoding")
+	// ErrDegenerateTriangle reports a triangle with coincident vertices.
+	ErrDegenerateTriangle = errors.New("degenerate triangle")
+	// ErrInvalidVertex reports an input vertex with a NaN or infinite component, the zero
+	// vector, or a vertex off the unit sphere.
+	ErrInvalidVertex = errors.New("invalid vertex")
+)
+
+// OptionError is returned by every option that rejects its value, and by constructors for
+// options that conflict with each other. Its Err wraps ErrInvalidOption, or ErrOutOfRange for
+// an index, so errors.Is keeps working; use errors.As to find the offending option.
+type OptionError struct {
+	// Name is the option constructor, such as "WithEps".
+	Name string
+	// Err describes why the option was rejected.
+	Err error
+}
+
+func (e *OptionError) Error() string {
+	return e.Name + ": " + e.Err.Error()
+}
+
+func (e *OptionError) Unwrap() error {
+	return e.Err
+}
+
+// maxDuplicatePairs is the number of pairs DuplicateError lists in its message.
+const maxDuplicatePairs = 8
+
+// DuplicateError is returned by NewTriangulation without WithDeduplication when the hull is
+// inconsistent because input vertices coincide, within 1e-12 radians of each other. It wraps
+// ErrInvalidHull.
+type DuplicateError struct {
+	// Pairs lists each duplicate as the index of the vertex it coincides with and its own
+	// index, in ascending order of the latter.
+	Pairs [][2]int
+}
+
+func (e *DuplicateError) Error() string {
+	if len(e.Pairs) > maxDuplicatePairs {
+		return fmt.Sprintf("%v: %d duplicate vertices %v...", ErrInvalidHull, len(e.Pairs),
+			e.Pairs[:maxDuplicatePairs])
+	}
+	return fmt.Sprintf("%v: %d duplicate vertices %v", ErrInvalidHull, len(e.Pairs), e.Pairs)
+}
+
+func (e *DuplicateError) Unwrap() error {
+	return ErrInvalidHull
+}
+
+// VertexError is returned by NewTriangulation when an input vertex is not a usable point, such
+// as one parsed from a malformed latitude or longitude or one off the unit sphere. It wraps
+// ErrInvalidVertex.
+type VertexError struct {
+	// Index is the index of the first invalid vertex in the input.
+	Index int
+	// Vertex is the invalid vertex.
+	Vertex s2.Point
+}
+
+func (e *VertexError) Error() string {
+	return fmt.Sprintf("%v: vertex %d is (%v, %v, %v) with norm %v", ErrInvalidVertex, e.Index,
+		e.Vertex.X, e.Vertex.Y, e.Vertex.Z, e.Vertex.Norm())
+}
+
+func (e *VertexError) Unwrap() error {
+	return ErrInvalidVertex
+}
+
+// DelaunayError is returned by Validate when a vertex lies inside the circumcap of a triangle.
+// It wraps ErrNotDelaunay.
+type DelaunayError struct {
+	// Triangle is the index of the first violating triangle.
+	Triangle int
+	// Vertex is the index of the vertex inside its circumcap.
+	Vertex int
+}
+
+func (e *DelaunayError) Error() string {
+	return fmt.Sprintf("%v: vertex %d is inside the circumcap of triangle %d", ErrNotDelaunay,
+		e.Vertex, e.Triangle)
+}
+
+func (e *DelaunayError) Unwrap() error {
+	return ErrNotDelaunay
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Errors
+
+func TestErrors_Is(t *testing.T) {
+	dt := mustNewTriangulation(t, 10)
+	coplanar := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(0, 1, 0),
+		s2.PointFromCoords(-1, 0, 0), s2.PointFromCoords(0, -1, 0),
+	}
+	call := func(f func() error) error { return f() }
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"insufficient vertices", call(func() error {
+			_, err := NewTriangulation(utils.GenerateRandomPoints(3, 0))
+			return err
+		}), ErrInsufficientVertices},
+		{"coplanar", call(func() error {
+			_, err := NewTriangulation(coplanar)
+			return err
+		}), ErrInvalidHull},
+		{"invalid eps", call(func() error {
+			_, err := NewTriangulation(utils.GenerateRandomPoints(10, 0), WithEps(0))
+			return err
+		}), ErrInvalidOption},
+		{"hull index count", call(func() error {
+			_, err := NewTriangulationFromHullIndices(coplanar, []int{0, 1, 2}, defaultEps)
+			return err
+		}), ErrInvalidHull},
+		{"incident triangles", call(func() error {
+			_, err := dt.IncidentTriangles(10)
+			return err
+		}), ErrOutOfRange},
+		{"triangle vertices", call(func() error {
+			_, err := dt.TriangleVertices(-1)
+			return err
+		}), ErrOutOfRange},
+		{"next vertex", call(func() error {
+			_, err := NextVertex([3]int{0, 1, 2}, 3)
+			return err
+		}), ErrNotInTriangle},
+		{"triangle id", call(func() error {
+			_, err := dt.TriangleByID(dt.TriangleID(0) + 1)
+			return err
+		}), ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.want) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", tt.err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionError(t *testing.T) {
+	points := utils.GenerateRandomPoints(10, 0)
+	tests := []struct {
+		name    string
+		setter  TriangulationOption
+		option  string
+		wantErr error
+	}{
+		{"eps", WithEps(0), "WithEps", ErrInvalidOption},
+		{"id level", WithIDLevel(-1), "WithIDLevel", ErrInvalidOption},
+		{"diagnostics", WithHullDiagnostics(nil), "WithHullDiagnostics", ErrInvalidOption},
+		{"metrics", WithMetrics(nil), "WithMetrics", ErrInvalidOption},
+		{"hull seed index", WithHullSeed([6]int{-1}), "WithHullSeed", ErrOutOfRange},
+		{"hull seed conflict", WithHullSeed([6]int{0, 1, 2, 3, 4, 5}), "WithHullSeed",
+			ErrInvalidOption},
+		{"deduplication", WithDeduplication(-1), "WithDeduplication", ErrInvalidOption},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTriangulation(points, tt.setter)
+			var oe *OptionError
+			if !errors.As(err, &oe) || oe.Name != tt.option {
+				t.Fatalf("NewTriangulation(...) error = %v, want OptionError for %s", err,
+					tt.option)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.wantErr)
+			}
+		})
+	}
+
+	_, err := mustNewTriangulation(t, 10).SplitEdge(0, 1, 0.5, WithAttributeInterpolator(nil))
+	var oe *OptionError
+	if !errors.As(err, &oe) || oe.Name != "WithAttributeInterpolator" {
+		t.Errorf("SplitEdge(..., WithAttributeInterpolator(nil)) error = %v, want OptionError",
+			err)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// inCircumcapErrorFactor bounds the rounding error of the floating-point in-circumcap
+	// determinant relative to the product of its edge lengths. Determinants within the bound are
+	// recomputed exactly.
+	inCircumcapErrorFactor = 1e-14
+)
+
+// MakeDelaunay restores the Delaunay property of a triangulation, such as one imported with
+// FromMesh, by Lawson edge flips. An edge is flipped while the vertex opposite it in one
+// triangle lies strictly inside the circumcap of the other. Each flip replaces two faces of the
+// polyhedron spanned by the vertices with two faces lying farther from the origin, so the
+// enclosed volume strictly grows, no triangulation repeats and the process terminates; maxFlips
+// bounds the work on large or badly scrambled meshes. It returns the number of flips performed.
+// The triangle and incidence arrays are rebuilt in place, so triangle indices change.
+// It returns an error if maxFlips is negative, the triangulation is partial, or the budget is
+// exhausted before the triangulation is Delaunay, in which case the flips performed so far are
+// kept.
+func (t *Triangulation) MakeDelaunay(maxFlips int) (int, error) {
+	if maxFlips < 0 {
+		return 0, fmt.Errorf("MakeDelaunay: %w: maxFlips must not be negative got %d",
+			ErrInvalidOption, maxFlips)
+	}
+	if t.Partial {
+		return 0, fmt.Errorf("MakeDelaunay: %w: triangulation is partial", ErrInvalidMesh)
+	}
+
+	m := newFlipMesh(t.Triangles)
+	var stack [][2]int
+	for _, tri := range m.tris {
+		for j := range 3 {
+			if tri[j] < tri[(j+1)%3] {
+				stack = append(stack, [2]int{tri[j], tri[(j+1)%3]})
+			}
+		}
+	}
+
+	flips, remaining, err := m.lawson(t.Vertices, stack, maxFlips)
+	if err != nil {
+		return 0, fmt.Errorf("MakeDelaunay: %w", err)
+	}
+	if remaining != nil {
+		err = fmt.Errorf("MakeDelaunay: %w: edge %v still violates the Delaunay criterion "+
+			"after %d flips", ErrNotDelaunay, *remaining, flips)
+	}
+	if flips > 0 {
+		if buildErr := t.setTriangles(m.tris); buildErr != nil {
+			return flips, fmt.Errorf("MakeDelaunay: %w", buildErr)
+		}
+	}
+	return flips, err
+}
+
+// setTriangles replaces the triangles, rebuilding the incidence arrays and resetting the
+// derived caches.
+func (t *Triangulation) setTriangles(tris []Triangle) error {
+	indices := make([]int, 0, 3*len(tris))
+	for _, tri := range tris {
+		indices = append(indices, tri[:]...)
+	}
+	u, err := newTriangulation(t.Vertices, indices, t.idLevel)
+	if err != nil {
+		return err
+	}
+	t.Triangles = u.Triangles
+	t.IncidentTriangleIndices = u.IncidentTriangleIndices
+	t.IncidentTriangleOffsets = u.IncidentTriangleOffsets
+	t.verticesHash, t.hashed = u.verticesHash, u.hashed
+	t.adjacencyOnce, t.adjacency = sync.Once{}, nil
+	t.edgesOnce, t.edges = sync.Once{}, nil
+	t.idsOnce, t.triangleIDs, t.idsErr = sync.Once{}, nil, nil
+	return nil
+}
+
+// flipMesh is a triangle mesh supporting edge flips, with every undirected edge mapped to the
+// two triangles sharing it.
+type flipMesh struct {
+	tris  []Triangle
+	edges map[[2]int][2]int
+}
+
+// newFlipMesh returns a flip mesh over a copy of the given closed, CCW triangles.
+func newFlipMesh(triangles []Triangle) *flipMesh {
+	m := &flipMesh{
+		tris:  make([]Triangle, len(triangles)),
+		edges: make(map[[2]int][2]int, 3*len(triangles)/2),
+	}
+	copy(m.tris, triangles)
+	for i, tri := range m.tris {
+		for j := range 3 {
+			m.setEdge(tri[j], tri[(j+1)%3], i)
+		}
+	}
+	return m
+}
+
+// edgeKey returns the map key of the undirected edge between a and b.
+func edgeKey(a, b int) [2]int {
+	return [2]int{min(a, b), max(a, b)}
+}
+
+// setEdge records that triangle tIdx traverses the edge from a to b. The triangle traversing
+// the edge from the smaller endpoint is stored first.
+func (m *flipMesh) setEdge(a, b, tIdx int) {
+	key := edgeKey(a, b)
+	faces := m.edges[key]
+	if a < b {
+		faces[0] = tIdx
+	} else {
+		faces[1] = tIdx
+	}
+	m.edges[key] = faces
+}
+
+// lawson flips the edges on the stack, and the edges around each flipped one, while the vertex
+// opposite an edge lies inside the circumcap of the other triangle. A negative maxFlips sets no
+// budget. It returns the number of flips performed and, if the budget was exhausted, the edge
+// that still violates the Delaunay criterion.
+// It returns an error wrapping ErrInvalidMesh if the mesh is inconsistent, in which case the
+// flips performed so far are kept.
+func (m *flipMesh) lawson(vertices s2.PointVector, stack [][2]int, maxFlips int) (int,
+	*[2]int, error) {
+	flips := 0
+	for len(stack) > 0 {
+		e := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		a, b, c, d, ok, err := m.quad(e[0], e[1])
+		if err != nil {
+			return flips, nil, err
+		}
+		if !ok || !InCircumcap(vertices[a], vertices[b], vertices[c], vertices[d]) {
+			continue
+		}
+		if flips == maxFlips {
+			return flips, &e, nil
+		}
+		if flipped, err := m.flip(a, b); err != nil {
+			return flips, nil, err
+		} else if !flipped {
+			continue
+		}
+		flips++
+		stack = append(stack, [2]int{a, d}, [2]int{d, b}, [2]int{b, c}, [2]int{c, a})
+	}
+	return flips, nil, nil
+}
+
+// quad returns the edge from a to b oriented as in its first triangle (a, b, c), and the vertex
+// d opposite it in the second triangle (b, a, d). It reports false if the edge does not exist.
+// It returns an error wrapping ErrInvalidMesh if a triangle recorded for the edge does not
+// contain it.
+func (m *flipMesh) quad(a, b int) (int, int, int, int, bool, error) {
+	faces, ok := m.edges[edgeKey(a, b)]
+	if !ok {
+		return 0, 0, 0, 0, false, nil
+	}
+	a, b = min(a, b), max(a, b)
+	c, err := NextVertex(m.tris[faces[0]], b)
+	if err != nil {
+		return 0, 0, 0, 0, false, fmt.Errorf("%w: edge %d-%d: %w", ErrInvalidMesh, a, b, err)
+	}
+	d, err := NextVertex(m.tris[faces[1]], a)
+	if err != nil {
+		return 0, 0, 0, 0, false, fmt.Errorf("%w: edge %d-%d: %w", ErrInvalidMesh, a, b, err)
+	}
+	return a, b, c, d, true, nil
+}
+
+// flip replaces the edge between a and b with the edge between the opposite vertices c and d,
+// turning triangles (a, b, c) and (b, a, d) into (a, d, c) and (d, b, c). It reports false
+// without changes if the edge does not exist or c and d are already connected.
+// It returns an error wrapping ErrInvalidMesh if the mesh is inconsistent.
+func (m *flipMesh) flip(a, b int) (bool, error) {
+	a, b, c, d, ok, err := m.quad(a, b)
+	if err != nil || !ok {
+		return false, err
+	}
+	if _, exists := m.edges[edgeKey(c, d)]; exists {
+		return false, nil
+	}
+	faces := m.edges[edgeKey(a, b)]
+	f, g := faces[0], faces[1]
+	delete(m.edges, edgeKey(a, b))
+	m.tris[f] = [3]int{a, d, c}
+	m.tris[g] = [3]int{d, b, c}
+	m.setEdge(a, d, f)
+	m.setEdge(d, c, f)
+	m.setEdge(c, a, f)
+	m.setEdge(d, b, g)
+	m.setEdge(b, c, g)
+	m.setEdge(c, d, g)
+	return true, nil
+}
+
+// InCircumcap reports whether d lies strictly inside the circumcap of the CCW triangle (a, b, c),
+// the part of the sphere beyond the plane of the triangle as seen from the origin. An edge of a
+// triangulation is Delaunay iff the vertex opposite it in either triangle is not inside the
+// circumcap of the other. The sign is recomputed in exact arithmetic when the floating-point
+// determinant is too small to trust.
+func InCircumcap(a, b, c, d s2.Point) bool {
+	ab, ac, ad := b.Sub(a.Vector), c.Sub(a.Vector), d.Sub(a.Vector)
+	det := ab.Cross(ac).Dot(ad)
+	bound := inCircumcapErrorFactor * ab.Norm() * ac.Norm() * ad.Norm()
+	if det > bound || det < -bound {
+		return det > 0
+	}
+	return exactOrient(a.Vector, b.Vector, c.Vector, d.Vector) > 0
+}
+
+// exactOrient returns the sign of (b-a)×(c-a)·(d-a) computed in exact rational arithmetic.
+func exactOrient(a, b, c, d r3.Vector) int {
+	sub := func(p, q r3.Vector) [3]*big.Rat {
+		var out [3]*big.Rat
+		for i, pair := range [3][2]float64{{p.X, q.X}, {p.Y, q.Y}, {p.Z, q.Z}} {
+			x := new(big.Rat).SetFloat64(pair[0])
+			out[i] = x.Sub(x, new(big.Rat).SetFloat64(pair[1]))
+		}
+		return out
+	}
+	u, v, w := sub(b, a), sub(c, a), sub(d, a)
+	mul := func(x, y *big.Rat) *big.Rat { return new(big.Rat).Mul(x, y) }
+	minor := func(i, j int) *big.Rat {
+		return new(big.Rat).Sub(mul(u[i], v[j]), mul(u[j], v[i]))
+	}
+	det := mul(minor(1, 2), w[0])
+	det.Add(det, mul(minor(2, 0), w[1]))
+	det.Add(det, mul(minor(0, 1), w[2]))
+	return det.Sign()
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/golang/geo/s2"
+)
+
+// Flip
+
+func TestMakeDelaunay(t *testing.T) {
+	src := mustNewTriangulation(t, 200)
+	scrambled := mustScramble(t, src, 300, 0)
+
+	dt, err := FromMesh(src.Vertices, scrambled)
+	if err != nil {
+		t.Fatalf("FromMesh(...) error = %v, want nil", err)
+	}
+	flips, err := dt.MakeDelaunay(10000)
+	if err != nil {
+		t.Fatalf("dt.MakeDelaunay(10000) error = %v, want nil", err)
+	}
+	if flips == 0 {
+		t.Errorf("dt.MakeDelaunay(10000) = 0 flips, want > 0")
+	}
+	if !slices.Equal(sortedTriangles(dt.Triangles), sortedTriangles(src.Triangles)) {
+		t.Errorf("dt.Triangles after MakeDelaunay differ from the Delaunay triangulation")
+	}
+	for i, tri := range dt.Triangles {
+		for v, p := range dt.Vertices {
+			a, b, c := dt.Vertices[tri[0]], dt.Vertices[tri[1]], dt.Vertices[tri[2]]
+			if InCircumcap(a, b, c, p) {
+				t.Fatalf("vertex %d inside circumcap of triangle %d", v, i)
+			}
+		}
+	}
+	if err := dt.checkStructure(); err != nil {
+		t.Errorf("dt.checkStructure() error = %v, want nil", err)
+	}
+
+	if flips, err := dt.MakeDelaunay(10); flips != 0 || err != nil {
+		t.Errorf("dt.MakeDelaunay(10) on Delaunay mesh = %d, %v, want 0, nil", flips, err)
+	}
+}
+
+func TestMakeDelaunay_Budget(t *testing.T) {
+	src := mustNewTriangulation(t, 100)
+	dt, err := FromMesh(src.Vertices, mustScramble(t, src, 100, 1))
+	if err != nil {
+		t.Fatalf("FromMesh(...) error = %v, want nil", err)
+	}
+	flips, err := dt.MakeDelaunay(1)
+	if !errors.Is(err, ErrNotDelaunay) || flips != 1 {
+		t.Errorf("dt.MakeDelaunay(1) = %d, %v, want 1, %v", flips, err, ErrNotDelaunay)
+	}
+	if err := dt.checkStructure(); err != nil {
+		t.Errorf("dt.checkStructure() error = %v, want nil", err)
+	}
+
+	if _, err := dt.MakeDelaunay(-1); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("dt.MakeDelaunay(-1) error = %v, want %v", err, ErrInvalidOption)
+	}
+}
+
+func TestFlipMesh_InvalidMesh(t *testing.T) {
+	dt := mustNewTriangulation(t, 50)
+	m := newFlipMesh(dt.Triangles)
+	tri := m.tris[0]
+	// Replace the triangle with one sharing no vertex with it, so its recorded edges dangle.
+	for _, other := range m.tris {
+		if !slices.ContainsFunc(other[:], func(v int) bool { return slices.Contains(tri[:], v) }) {
+			m.tris[0] = other
+			break
+		}
+	}
+	if _, _, _, _, _, err := m.quad(tri[0], tri[1]); !errors.Is(err, ErrInvalidMesh) {
+		t.Errorf("m.quad(...) error = %v, want %v", err, ErrInvalidMesh)
+	}
+	if _, err := m.flip(tri[0], tri[1]); !errors.Is(err, ErrInvalidMesh) {
+		t.Errorf("m.flip(...) error = %v, want %v", err, ErrInvalidMesh)
+	}
+	stack := [][2]int{{tri[0], tri[1]}}
+	if _, _, err := m.lawson(dt.Vertices, stack, -1); !errors.Is(err, ErrInvalidMesh) {
+		t.Errorf("m.lawson(...) error = %v, want %v", err, ErrInvalidMesh)
+	}
+}
+
+func TestInCircumcap(t *testing.T) {
+	a := s2.PointFromCoords(1, 0, 0)
+	b := s2.PointFromCoords(0, 1, 0)
+	c := s2.PointFromCoords(0, 0, 1)
+	tests := []struct {
+		name string
+		d    s2.Point
+		want bool
+	}{
+		{"inside", s2.PointFromCoords(1, 1, 1), true},
+		{"outside", s2.PointFromCoords(-1, -1, -1), false},
+		{"on circle", a, false},
+		// Both are rounded onto the circle by a floating-point determinant.
+		{"just inside", s2.PointFromCoords(1, 1e-17, 0), true},
+		{"just outside", s2.PointFromCoords(1, -1e-17, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InCircumcap(a, b, c, tt.d); got != tt.want {
+				t.Errorf("InCircumcap(a, b, c, %v) = %v, want %v", tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+// mustScramble applies up to n random flips that keep every triangle CCW to the triangles of dt.
+func mustScramble(t *testing.T, dt *Triangulation, n int, seed int64) []Triangle {
+	t.Helper()
+	rng := rand.New(rand.NewSource(seed))
+	m := newFlipMesh(dt.Triangles)
+	flipped := 0
+	for range 100 * n {
+		if flipped == n {
+			break
+		}
+		tri := m.tris[rng.Intn(len(m.tris))]
+		j := rng.Intn(3)
+		a, b, c, d, _, err := m.quad(tri[j], tri[(j+1)%3])
+		if err != nil {
+			t.Fatalf("m.quad(...) error = %v, want nil", err)
+		}
+		if meshOrientation([3]int{a, d, c}, dt.Vertices) <= 0 ||
+			meshOrientation([3]int{d, b, c}, dt.Vertices) <= 0 {
+			continue
+		}
+		if ok, err := m.flip(a, b); err != nil {
+			t.Fatalf("m.flip(...) error = %v, want nil", err)
+		} else if ok {
+			flipped++
+		}
+	}
+	if flipped == 0 {
+		t.Fatalf("mustScramble(...) performed no flips")
+	}
+	return m.tris
+}
+
+// sortedTriangles returns the vertex sets of the triangles in a canonical order.
+func sortedTriangles(tris []Triangle) []Triangle {
+	out := make([]Triangle, len(tris))
+	for i, tri := range tris {
+		slices.Sort(tri[:])
+		out[i] = tri
+	}
+	slices.SortFunc(out, func(x, y Triangle) int { return slices.Compare(x[:], y[:]) })
+	return out
+}
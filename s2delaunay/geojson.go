@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	Geometry   geoJSONPolygon    `json:"geometry"`
+	Properties geoJSONProperties `json:"properties"`
+}
+
+type geoJSONPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+type geoJSONProperties struct {
+	Triangle int    `json:"triangle"`
+	Vertices [3]int `json:"vertices"`
+}
+
+// MarshalGeoJSON encodes the triangulation as a GeoJSON FeatureCollection with one Polygon
+// feature per triangle, in Triangles order, for inspection in GIS tools. Positions are
+// [longitude, latitude] in degrees, and the properties hold the triangle index and its three
+// vertex indices.
+// Each ring is closed by repeating its first position and wound counterclockwise in the
+// longitude-latitude plane, as RFC 7946 requires of exterior rings, which follows from the
+// triangles being CCW when looking out of the sphere. Triangles are not split at the
+// antimeridian: the longitudes of a ring are unwrapped so that consecutive positions differ by
+// at most 180°, and a triangle crossing it has longitudes beyond ±180. A triangle containing
+// a pole is closed through the pole along the latitude ±90 at both ends of its unwrapped
+// longitude span.
+func (t *Triangulation) MarshalGeoJSON() ([]byte, error) {
+	fc := geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]geoJSONFeature, len(t.Triangles)),
+	}
+	for i, tri := range t.Triangles {
+		fc.Features[i] = geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONPolygon{
+				Type:        "Polygon",
+				Coordinates: [][][2]float64{geoJSONRing(t.Vertices, tri)},
+			},
+			Properties: geoJSONProperties{Triangle: i, Vertices: tri},
+		}
+	}
+	data, err := json.Marshal(fc)
+	if err != nil {
+		return nil, fmt.Errorf("MarshalGeoJSON: %w", err)
+	}
+	return data, nil
+}
+
+// geoJSONRing returns the closed [longitude, latitude] ring of the triangle with unwrapped
+// longitudes, detouring through the pole the triangle contains, if any.
+func geoJSONRing(vertices s2.PointVector, tri Triangle) [][2]float64 {
+	ring := make([][2]float64, 0, len(tri)+3)
+	for k, v := range tri {
+		ll := s2.LatLngFromPoint(vertices[v])
+		lng, lat := ll.Lng.Degrees(), ll.Lat.Degrees()
+		if k > 0 {
+			prev := ring[k-1][0]
+			for lng-prev > 180 {
+				lng -= 360
+			}
+			for lng-prev < -180 {
+				lng += 360
+			}
+		}
+		ring = append(ring, [2]float64{lng, lat})
+	}
+
+	// Closing the ring back to the first longitude turns it by a full 360° around the pole it
+	// contains: eastward around the north pole, westward around the south pole.
+	first, last := ring[0], ring[len(ring)-1]
+	closing := first[0] - last[0]
+	for closing > 180 {
+		closing -= 360
+	}
+	for closing < -180 {
+		closing += 360
+	}
+	if span := last[0] + closing - first[0]; span > 180 || span < -180 {
+		pole := 90.0
+		if span < 0 {
+			pole = -90
+		}
+		ring = append(ring, [2]float64{last[0], pole}, [2]float64{first[0], pole})
+	}
+	return append(ring, first)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/golang/geo/s2"
+)
+
+// GeoJSON
+
+type testGeoJSON struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Type     string `json:"type"`
+		Geometry struct {
+			Type        string         `json:"type"`
+			Coordinates [][][2]float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties struct {
+			Triangle int    `json:"triangle"`
+			Vertices [3]int `json:"vertices"`
+		} `json:"properties"`
+	} `json:"features"`
+}
+
+func mustUnmarshalGeoJSON(t *testing.T, dt *Triangulation) testGeoJSON {
+	t.Helper()
+	data, err := dt.MarshalGeoJSON()
+	if err != nil {
+		t.Fatalf("MarshalGeoJSON() error = %v, want nil", err)
+	}
+	var fc testGeoJSON
+	if err := json.Unmarshal(data, &fc); err != nil {
+		t.Fatalf("json.Unmarshal(MarshalGeoJSON()) error = %v, want nil", err)
+	}
+	return fc
+}
+
+// ringSignedArea returns the shoelace area of the closed ring, positive if it is CCW.
+func ringSignedArea(ring [][2]float64) float64 {
+	area := 0.0
+	for i := range len(ring) - 1 {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return area / 2
+}
+
+func TestMarshalGeoJSON(t *testing.T) {
+	dt := mustNewTriangulation(t, 200)
+	fc := mustUnmarshalGeoJSON(t, dt)
+	if fc.Type != "FeatureCollection" {
+		t.Errorf("type = %q, want %q", fc.Type, "FeatureCollection")
+	}
+	if len(fc.Features) != len(dt.Triangles) {
+		t.Fatalf("len(features) = %d, want %d", len(fc.Features), len(dt.Triangles))
+	}
+	for i, f := range fc.Features {
+		if f.Type != "Feature" || f.Geometry.Type != "Polygon" {
+			t.Errorf("feature %d: types = %q, %q, want Feature, Polygon", i, f.Type,
+				f.Geometry.Type)
+		}
+		if f.Properties.Triangle != i || f.Properties.Vertices != dt.Triangles[i] {
+			t.Errorf("feature %d: properties = %d, %v, want %d, %v", i, f.Properties.Triangle,
+				f.Properties.Vertices, i, dt.Triangles[i])
+		}
+		if len(f.Geometry.Coordinates) != 1 {
+			t.Fatalf("feature %d: %d rings, want 1", i, len(f.Geometry.Coordinates))
+		}
+		ring := f.Geometry.Coordinates[0]
+		if ring[0] != ring[len(ring)-1] {
+			t.Errorf("feature %d: ring %v is not closed", i, ring)
+		}
+		for k := range len(ring) - 1 {
+			if math.Abs(ring[k][1]) == 90 && ring[k+1][1] == ring[k][1] {
+				continue
+			}
+			if d := math.Abs(ring[k+1][0] - ring[k][0]); d > 180 {
+				t.Errorf("feature %d: longitude jumps by %v in ring %v", i, d, ring)
+			}
+		}
+		for k, v := range dt.Triangles[i] {
+			ll := s2.LatLngFromPoint(dt.Vertices[v])
+			if math.Abs(ring[k][1]-ll.Lat.Degrees()) > 1e-12 {
+				t.Errorf("feature %d: latitude %d = %v, want %v", i, k, ring[k][1],
+					ll.Lat.Degrees())
+			}
+			if d := math.Remainder(ring[k][0]-ll.Lng.Degrees(), 360); math.Abs(d) > 1e-12 {
+				t.Errorf("feature %d: longitude %d = %v, want %v mod 360", i, k, ring[k][0],
+					ll.Lng.Degrees())
+			}
+		}
+		if area := ringSignedArea(ring); area <= 0 {
+			t.Errorf("feature %d: ring %v has signed area %v, want > 0", i, ring, area)
+		}
+	}
+}
+
+func TestMarshalGeoJSON_Poles(t *testing.T) {
+	// An antiprism whose northern and southern triangles each contain a pole.
+	var vertices s2.PointVector
+	for _, lng := range []float64{10, 130, 250} {
+		vertices = append(vertices, s2.PointFromLatLng(s2.LatLngFromDegrees(80, lng)),
+			s2.PointFromLatLng(s2.LatLngFromDegrees(-80, lng+60)))
+	}
+	dt, err := NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation() error = %v, want nil", err)
+	}
+	fc := mustUnmarshalGeoJSON(t, dt)
+	poles := map[float64]int{}
+	for i, f := range fc.Features {
+		ring := f.Geometry.Coordinates[0]
+		if area := ringSignedArea(ring); area <= 0 {
+			t.Errorf("feature %d: ring %v has signed area %v, want > 0", i, ring, area)
+		}
+		if len(ring) == 6 {
+			if pole := ring[3][1]; math.Abs(pole) != 90 || ring[4][1] != pole {
+				t.Errorf("feature %d: ring %v does not pass through a pole", i, ring)
+			} else {
+				poles[pole]++
+			}
+		}
+	}
+	if poles[90] != 1 || poles[-90] != 1 {
+		t.Errorf("rings through the poles = %v, want one through each", poles)
+	}
+}
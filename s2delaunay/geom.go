@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// SphericalTriangleArea returns the area of the spherical triangle abc in steradians. It is
+// accurate for small and needle-like triangles alike, following s2.PointArea.
+func SphericalTriangleArea(a, b, c s2.Point) float64 {
+	return s2.PointArea(a, b, c)
+}
+
+// Circumcenter returns the center of the circle through a, b and c on the sphere, taken on
+// the side of the triangle so that it is the center of the smaller circumcap.
+// It returns an error wrapping ErrDegenerateTriangle if two of the points coincide, in which
+// case the circle is not unique.
+func Circumcenter(a, b, c s2.Point) (s2.Point, error) {
+	n := a.Sub(b.Vector).Cross(b.Sub(c.Vector))
+	if n == (r3.Vector{}) {
+		return s2.Point{}, fmt.Errorf("Circumcenter: %w", ErrDegenerateTriangle)
+	}
+	// n points to the side from which the triangle appears CCW, which holds the smaller
+	// circumcap iff the triangle is CCW. The orientation is decided exactly: the sign of n
+	// against the vertex sum is lost to rounding when the plane of the circle passes near the
+	// origin, that is for a circumradius near 90°, and picked the antipode.
+	if s2.RobustSign(a, b, c) == s2.Clockwise {
+		n = n.Mul(-1)
+	}
+	return s2.Point{Vector: n.Normalize()}, nil
+}
+
+// PointInTriangle reports whether p lies in the spherical triangle abc, which may be given in
+// either orientation. Orientations are evaluated with s2.RobustSign, whose symbolic
+// perturbation assigns a point on an edge to exactly one of the two triangles sharing it, so
+// every point away from the vertices is contained by exactly one triangle of a triangulation.
+// The vertices themselves are contained.
+func PointInTriangle(p, a, b, c s2.Point) bool {
+	if s2.RobustSign(a, b, c) == s2.Clockwise {
+		b, c = c, b
+	}
+	return s2.RobustSign(a, b, p) != s2.Clockwise &&
+		s2.RobustSign(b, c, p) != s2.Clockwise &&
+		s2.RobustSign(c, a, p) != s2.Clockwise
+}
+
+// EdgeIntersection returns the point where the geodesic edges a0a1 and b0b1 cross at a point
+// interior to both, and false if they do not, as decided by s2.CrossingSign. Edges sharing a
+// vertex do not cross.
+func EdgeIntersection(a0, a1, b0, b1 s2.Point) (s2.Point, bool) {
+	if s2.CrossingSign(a0, a1, b0, b1) != s2.Cross {
+		return s2.Point{}, false
+	}
+	return s2.Intersection(a0, a1, b0, b1), true
+}
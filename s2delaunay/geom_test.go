@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// Geometry
+
+var (
+	geomX = s2.PointFromCoords(1, 0, 0)
+	geomY = s2.PointFromCoords(0, 1, 0)
+	geomZ = s2.PointFromCoords(0, 0, 1)
+)
+
+func TestSphericalTriangleArea(t *testing.T) {
+	if got := SphericalTriangleArea(geomX, geomY, geomZ); math.Abs(got-math.Pi/2) > 1e-15 {
+		t.Errorf("SphericalTriangleArea(octant) = %v, want %v", got, math.Pi/2)
+	}
+
+	dt := mustNewTriangulation(t, 1000)
+	total := 0.0
+	for i := range dt.Triangles {
+		p, err := dt.TriangleVertices(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleVertices(%d) error = %v, want nil", i, err)
+		}
+		total += SphericalTriangleArea(p[0], p[1], p[2])
+	}
+	if math.Abs(total-4*math.Pi) > 1e-10 {
+		t.Errorf("total triangle area = %v, want %v", total, 4*math.Pi)
+	}
+}
+
+func TestCircumcenter(t *testing.T) {
+	// A CCW triangle within 1e-17 of a great circle, for which the vertex sum has the wrong sign
+	// against the plane normal in floating point.
+	nearA := s2.Point{Vector: r3.Vector{X: -0.8646213991875666, Y: -0.5018812339670606,
+		Z: -0.023346585588369644}}
+	nearB := s2.Point{Vector: r3.Vector{X: 0.9973659541848422, Y: -0.03434966217054969,
+		Z: 0.06388469411156852}}
+	nearC := s2.Point{Vector: r3.Vector{X: -0.09043627046057574, Y: 0.99374702900099,
+		Z: -0.06548376391814684}}
+	nearWant := s2.PointFromCoords(-0.1501660285496893, 0.1459920526603598, 2.4228849208976397)
+
+	tests := []struct {
+		name    string
+		a, b, c s2.Point
+		want    s2.Point
+		wantErr error
+	}{
+		{"xyz orthonormal", geomX, geomY, geomZ, s2.PointFromCoords(1, 1, 1), nil},
+		{"xyz orthonormal reversed", geomZ, geomY, geomX, s2.PointFromCoords(1, 1, 1), nil},
+		{"great circle", geomX, geomY, s2.PointFromCoords(-1, 0, 0), geomZ, nil},
+		{"near great circle", nearA, nearB, nearC, nearWant, nil},
+		{"near great circle reversed", nearC, nearB, nearA, nearWant, nil},
+		{"coincident", geomX, geomX, geomY, s2.Point{}, ErrDegenerateTriangle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Circumcenter(tt.a, tt.b, tt.c)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Circumcenter(...) error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.Distance(tt.want) > 1e-15 {
+				t.Errorf("Circumcenter(...) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCircumcenter_Equidistant(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	for i := range dt.Triangles {
+		p, err := dt.TriangleVertices(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleVertices(%d) error = %v, want nil", i, err)
+		}
+		c, err := Circumcenter(p[0], p[1], p[2])
+		if err != nil {
+			t.Fatalf("Circumcenter(triangle %d) error = %v, want nil", i, err)
+		}
+		d0, d1, d2 := c.Distance(p[0]), c.Distance(p[1]), c.Distance(p[2])
+		if math.Abs((d0-d1).Radians()) > 1e-12 || math.Abs((d0-d2).Radians()) > 1e-12 {
+			t.Fatalf("Circumcenter(triangle %d) distances = %v, %v, %v, want equal", i, d0, d1,
+				d2)
+		}
+		if !c.IsUnit() {
+			t.Fatalf("Circumcenter(triangle %d) = %v, want unit length", i, c)
+		}
+	}
+}
+
+func TestPointInTriangle(t *testing.T) {
+	tests := []struct {
+		name string
+		p    s2.Point
+		want bool
+	}{
+		{"interior", s2.PointFromCoords(1, 1, 1), true},
+		{"vertex", geomX, true},
+		{"outside", s2.PointFromCoords(-1, 1, 1), false},
+		{"antipodal", s2.PointFromCoords(-1, -1, -1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PointInTriangle(tt.p, geomX, geomY, geomZ); got != tt.want {
+				t.Errorf("PointInTriangle(%v, ccw) = %v, want %v", tt.p, got, tt.want)
+			}
+			if got := PointInTriangle(tt.p, geomX, geomZ, geomY); got != tt.want {
+				t.Errorf("PointInTriangle(%v, cw) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointInTriangle_SharedEdge(t *testing.T) {
+	below := s2.PointFromCoords(0, 0, -1)
+	for _, p := range []s2.Point{s2.PointFromCoords(1, 1, 0), s2.PointFromCoords(3, 1, 0)} {
+		upper, lower := PointInTriangle(p, geomX, geomY, geomZ), PointInTriangle(p, geomY, geomX,
+			below)
+		if upper == lower {
+			t.Errorf("PointInTriangle(%v) = %v, %v for the triangles sharing the edge, want "+
+				"exactly one true", p, upper, lower)
+		}
+	}
+}
+
+func TestEdgeIntersection(t *testing.T) {
+	tests := []struct {
+		name           string
+		a0, a1, b0, b1 s2.Point
+		want           s2.Point
+		wantOK         bool
+	}{
+		{
+			"crossing",
+			s2.PointFromCoords(1, -1, 1), s2.PointFromCoords(1, 1, 1),
+			s2.PointFromCoords(1, 0, 0), geomZ,
+			s2.PointFromCoords(1, 0, 1), true,
+		},
+		{
+			"disjoint",
+			geomX, geomY,
+			s2.PointFromCoords(1, 1, 1), geomZ,
+			s2.Point{}, false,
+		},
+		{
+			"shared vertex",
+			geomX, geomY,
+			geomX, geomZ,
+			s2.Point{}, false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := EdgeIntersection(tt.a0, tt.a1, tt.b0, tt.b1)
+			if ok != tt.wantOK {
+				t.Fatalf("EdgeIntersection(...) ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got.Distance(tt.want) > 1e-15 {
+				t.Errorf("EdgeIntersection(...) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	// exactDiameterLimit is the largest vertex count for which GraphMetrics runs a breadth-first
+	// search from every vertex.
+	exactDiameterLimit = 1024
+	// diameterSweeps is the number of breadth-first searches used to bound the diameter of larger
+	// graphs.
+	diameterSweeps = 8
+)
+
+// GraphMetrics describes the vertex-edge graph of a triangulation.
+type GraphMetrics struct {
+	// Diameter is the largest number of edges on a shortest path between two vertices.
+	Diameter int
+	// AvgDegree is the mean number of edges incident to a vertex.
+	AvgDegree float64
+	// ClusteringCoefficient is the mean over vertices of the fraction of neighbor pairs that are
+	// themselves connected by an edge.
+	ClusteringCoefficient float64
+}
+
+// GraphMetrics computes global descriptors of the Delaunay graph. The diameter is exact for
+// triangulations with at most 1024 vertices. For larger ones it is a lower bound
+// found by repeated double sweeps, each starting a breadth-first search from the farthest vertex
+// reached by the previous one, which is exact or nearly so on meshes of well spread points.
+// It returns an error wrapping ErrInvalidMesh if an incident triangle of a vertex does not
+// contain it.
+func (t *Triangulation) GraphMetrics() (GraphMetrics, error) {
+	n := len(t.Vertices)
+	if n == 0 {
+		return GraphMetrics{}, nil
+	}
+	neighbors := make([][]int, n)
+	for v := range neighbors {
+		ring, err := t.vertexNeighbors(v)
+		if err != nil {
+			return GraphMetrics{}, fmt.Errorf("GraphMetrics: %w", err)
+		}
+		neighbors[v] = ring
+	}
+
+	var m GraphMetrics
+	var degrees, clustering float64
+	for _, ring := range neighbors {
+		degrees += float64(len(ring))
+		if len(ring) < 2 {
+			continue
+		}
+		links := 0
+		for i, a := range ring {
+			for _, b := range ring[i+1:] {
+				if slices.Contains(neighbors[a], b) {
+					links++
+				}
+			}
+		}
+		k := float64(len(ring))
+		clustering += float64(links) / (k * (k - 1) / 2)
+	}
+	m.AvgDegree = degrees / float64(n)
+	m.ClusteringCoefficient = clustering / float64(n)
+
+	dist := make([]int, n)
+	if n <= exactDiameterLimit {
+		for v := range n {
+			_, d := bfsFarthest(neighbors, v, dist)
+			m.Diameter = max(m.Diameter, d)
+		}
+		return m, nil
+	}
+	start := 0
+	for range diameterSweeps {
+		far, d := bfsFarthest(neighbors, start, dist)
+		m.Diameter = max(m.Diameter, d)
+		start = far
+	}
+	return m, nil
+}
+
+// vertexNeighbors returns the vertices sharing an edge with the vertex at the given index, in
+// CCW order when looking out of the sphere.
+// It returns an error wrapping ErrInvalidMesh if an incident triangle does not contain the
+// vertex.
+func (t *Triangulation) vertexNeighbors(vIdx int) ([]int, error) {
+	start, end := t.IncidentTriangleOffsets[vIdx], t.IncidentTriangleOffsets[vIdx+1]
+	incident := t.IncidentTriangleIndices[start:end]
+	ring := make([]int, 0, len(incident))
+	for _, tIdx := range incident {
+		next, err := NextVertex(t.Triangles[tIdx], vIdx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: triangle %d incident to vertex %d: %w", ErrInvalidMesh,
+				tIdx, vIdx, err)
+		}
+		ring = append(ring, next)
+	}
+	return ring, nil
+}
+
+// bfsFarthest runs a breadth-first search from start over the adjacency lists, using dist as
+// scratch space, and returns the last vertex reached together with its hop distance.
+func bfsFarthest(neighbors [][]int, start int, dist []int) (int, int) {
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[start] = 0
+	queue := []int{start}
+	last := start
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		last = v
+		for _, u := range neighbors[v] {
+			if dist[u] < 0 {
+				dist[u] = dist[v] + 1
+				queue = append(queue, u)
+			}
+		}
+	}
+	return last, dist[last]
+}
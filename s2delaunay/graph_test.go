@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"io"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Graph
+
+func TestGraphMetrics_Polyhedra(t *testing.T) {
+	octahedron, err := NewTriangulation(s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(-1, 0, 0),
+		s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(0, -1, 0),
+		s2.PointFromCoords(0, 0, 1), s2.PointFromCoords(0, 0, -1),
+	})
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name string
+		dt   *Triangulation
+		want GraphMetrics
+	}{
+		{"tetrahedron", mustNewTetrahedron(t), GraphMetrics{1, 3, 1}},
+		// The four neighbors of an octahedron vertex form a cycle without chords.
+		{"octahedron", octahedron, GraphMetrics{2, 4, 2.0 / 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dt.GraphMetrics()
+			if err != nil {
+				t.Fatalf("dt.GraphMetrics() error = %v, want nil", err)
+			}
+			if got.Diameter != tt.want.Diameter ||
+				math.Abs(got.AvgDegree-tt.want.AvgDegree) > 1e-12 ||
+				math.Abs(got.ClusteringCoefficient-tt.want.ClusteringCoefficient) > 1e-12 {
+				t.Errorf("dt.GraphMetrics() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphMetrics_Random(t *testing.T) {
+	for _, n := range []int{100, 2000} {
+		dt, err := NewTriangulation(utils.GenerateRandomPoints(n, 0))
+		if err != nil {
+			t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+		}
+		got, err := dt.GraphMetrics()
+		if err != nil {
+			t.Fatalf("n=%d: dt.GraphMetrics() error = %v, want nil", n, err)
+		}
+
+		// A triangulation of the sphere has 3n-6 edges.
+		if want := 2 * float64(3*n-6) / float64(n); math.Abs(got.AvgDegree-want) > 1e-12 {
+			t.Errorf("n=%d: got.AvgDegree = %v, want %v", n, got.AvgDegree, want)
+		}
+		if got.ClusteringCoefficient <= 0 || got.ClusteringCoefficient > 1 {
+			t.Errorf("n=%d: got.ClusteringCoefficient = %v, want in (0, 1]", n,
+				got.ClusteringCoefficient)
+		}
+
+		neighbors := make([][]int, n)
+		for v := range neighbors {
+			neighbors[v], _ = dt.IncidentVertices(v)
+		}
+		exact := 0
+		dist := make([]int, n)
+		for v := range n {
+			_, d := bfsFarthest(neighbors, v, dist)
+			exact = max(exact, d)
+		}
+		if got.Diameter <= 0 || got.Diameter > exact {
+			t.Errorf("n=%d: got.Diameter = %d, want in (0, %d]", n, got.Diameter, exact)
+		}
+		if n <= exactDiameterLimit && got.Diameter != exact {
+			t.Errorf("n=%d: got.Diameter = %d, want %d", n, got.Diameter, exact)
+		}
+	}
+}
+
+func TestVertexNeighbors_InvalidMesh(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(dt *Triangulation) error
+	}{
+		{"IncidentVertices", func(dt *Triangulation) error {
+			_, err := dt.IncidentVertices(0)
+			return err
+		}},
+		{"DumpInternals", func(dt *Triangulation) error { return dt.DumpInternals(io.Discard) }},
+		{"GraphMetrics", func(dt *Triangulation) error {
+			_, err := dt.GraphMetrics()
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dt := mustNewTriangulation(t, 50)
+			dt.IncidentTriangleIndices = slices.Clone(dt.IncidentTriangleIndices)
+			// Point the first incident triangle of vertex 0 at one that does not contain it.
+			for tIdx, tri := range dt.Triangles {
+				if !slices.Contains(tri[:], 0) {
+					dt.IncidentTriangleIndices[dt.IncidentTriangleOffsets[0]] = tIdx
+					break
+				}
+			}
+			if err := tt.call(dt); !errors.Is(err, ErrInvalidMesh) {
+				t.Errorf("%s error = %v, want %v", tt.name, err, ErrInvalidMesh)
+			}
+		})
+	}
+}
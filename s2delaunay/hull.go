@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/markus-wa/quickhull-go/v2"
+)
+
+// HullDiagnostics describes a QuickHull run performed by NewTriangulation.
+type HullDiagnostics struct {
+	// FaceCount is the number of triangles in the hull returned by QuickHull.
+	FaceCount int
+	// Discarded lists in ascending order the vertices used by no hull triangle, such as
+	// vertices QuickHull treated as interior or as coincident with another vertex.
+	Discarded []int
+	// Extremes are the vertices used to seed the hull, attaining the maximum and minimum X, Y
+	// and Z coordinates in that order.
+	Extremes [6]int
+	// Duration is the time spent in QuickHull.
+	Duration time.Duration
+}
+
+// WithHullDiagnostics makes NewTriangulation fill diag with the outcome of its QuickHull run.
+// The diagnostics are filled whenever QuickHull runs, including when the hull is then rejected
+// with an error.
+func WithHullDiagnostics(diag *HullDiagnostics) TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		if diag == nil {
+			return &OptionError{Name: "WithHullDiagnostics",
+				Err: fmt.Errorf("%w: diag must not be nil", ErrInvalidOption)}
+		}
+		o.Diagnostics = diag
+		return nil
+	}
+}
+
+// WithHullSeed pins the six extreme vertices QuickHull starts from, given like
+// HullDiagnostics.Extremes, so that inputs with several vertices attaining an extreme
+// coordinate, such as symmetric inputs, are triangulated deterministically regardless of their
+// order. Each seed must attain the extreme coordinate it is given for, and a vertex attaining
+// several of them must be given for the first.
+func WithHullSeed(extremes [6]int) TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		for _, v := range extremes {
+			if v < 0 {
+				return &OptionError{Name: "WithHullSeed",
+					Err: fmt.Errorf("index %d %w", v, ErrOutOfRange)}
+			}
+		}
+		o.HullSeed = extremes[:]
+		return nil
+	}
+}
+
+// convexHull runs QuickHull over the vertices and returns the flat CCW triangle indices of the
+// hull, seeding it and filling the diagnostics as configured by opts.
+// It returns an error if the hull seed is invalid for the vertices.
+func convexHull(vertices []r3.Vector, opts TriangulationOptions) ([]int, error) {
+	// QuickHull seeds the hull with the first vertex attaining each extreme coordinate and
+	// breaks later ties by position, so the seed vertices are moved to the front and the others
+	// are sorted by coordinates to make the hull independent of the input order.
+	order := make([]int, 0, len(vertices))
+	if opts.HullSeed != nil {
+		if len(opts.HullSeed) != 6 {
+			return nil, &OptionError{Name: "WithHullSeed",
+				Err: fmt.Errorf("%w: hull seed has %d vertices, want 6", ErrInvalidOption,
+					len(opts.HullSeed))}
+		}
+		seen := make(map[int]bool)
+		for _, v := range opts.HullSeed {
+			if v < 0 || v >= len(vertices) {
+				return nil, &OptionError{Name: "WithHullSeed",
+					Err: fmt.Errorf("hull seed %d %w [0 %d)", v, ErrOutOfRange, len(vertices))}
+			}
+			if !seen[v] {
+				seen[v] = true
+				order = append(order, v)
+			}
+		}
+		numSeeds := len(order)
+		for i := range vertices {
+			if !seen[i] {
+				order = append(order, i)
+			}
+		}
+		slices.SortStableFunc(order[numSeeds:], func(a, b int) int {
+			va, vb := vertices[a], vertices[b]
+			return cmp.Or(cmp.Compare(va.X, vb.X), cmp.Compare(va.Y, vb.Y),
+				cmp.Compare(va.Z, vb.Z))
+		})
+	} else {
+		for i := range vertices {
+			order = append(order, i)
+		}
+	}
+	ordered := make([]r3.Vector, len(vertices))
+	for i, v := range order {
+		ordered[i] = vertices[v]
+	}
+
+	var extremes [6]int
+	for k, i := range hullExtremes(ordered) {
+		extremes[k] = order[i]
+	}
+	if opts.HullSeed != nil && [6]int(opts.HullSeed) != extremes {
+		return nil, &OptionError{Name: "WithHullSeed",
+			Err: fmt.Errorf("%w: hull seed %v does not match extremes %v", ErrInvalidOption,
+				opts.HullSeed, extremes)}
+	}
+
+	start := time.Now()
+	qh := new(quickhull.QuickHull)
+	ch := qh.ConvexHull(ordered, true, true, opts.Eps)
+	elapsed := time.Since(start)
+
+	indices := make([]int, len(ch.Indices))
+	used := make([]bool, len(vertices))
+	for i, idx := range ch.Indices {
+		indices[i] = order[idx]
+		used[indices[i]] = true
+	}
+	if diag := opts.Diagnostics; diag != nil {
+		*diag = HullDiagnostics{
+			FaceCount: len(indices) / 3,
+			Extremes:  extremes,
+			Duration:  elapsed,
+		}
+		for v, ok := range used {
+			if !ok {
+				diag.Discarded = append(diag.Discarded, v)
+			}
+		}
+	}
+	return indices, nil
+}
+
+// hullExtremes returns the first vertices attaining the maximum and minimum X, Y and Z
+// coordinates, matching the choice QuickHull makes for its initial hull.
+func hullExtremes(vertices []r3.Vector) [6]int {
+	var idx [6]int
+	var val [6]float64
+	for i, v := range vertices {
+		for axis, c := range [3]float64{v.X, v.Y, v.Z} {
+			if i == 0 {
+				val[2*axis], val[2*axis+1] = c, c
+				continue
+			}
+			if c > val[2*axis] {
+				val[2*axis], idx[2*axis] = c, i
+			} else if c < val[2*axis+1] {
+				val[2*axis+1], idx[2*axis+1] = c, i
+			}
+		}
+	}
+	return idx
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Hull
+
+func TestWithHullDiagnostics(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	var diag HullDiagnostics
+	dt, err := NewTriangulation(points, WithHullDiagnostics(&diag))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	if diag.FaceCount != len(dt.Triangles) {
+		t.Errorf("diag.FaceCount = %d, want %d", diag.FaceCount, len(dt.Triangles))
+	}
+	if len(diag.Discarded) != 0 {
+		t.Errorf("diag.Discarded = %v, want empty", diag.Discarded)
+	}
+	if diag.Duration <= 0 {
+		t.Errorf("diag.Duration = %v, want positive", diag.Duration)
+	}
+	if maxX := points[diag.Extremes[0]].X; slices.ContainsFunc(points, func(p s2.Point) bool {
+		return p.X > maxX
+	}) {
+		t.Errorf("diag.Extremes[0] = %d does not attain the maximum X", diag.Extremes[0])
+	}
+
+	if err := WithHullDiagnostics(nil)(&TriangulationOptions{}); !errors.Is(err,
+		ErrInvalidOption) {
+		t.Errorf("WithHullDiagnostics(nil) error = %v, want ErrInvalidOption", err)
+	}
+}
+
+func TestWithHullDiagnostics_Duplicate(t *testing.T) {
+	points := utils.GenerateRandomPoints(50, 0)
+	points = append(points, points[7])
+
+	diag := HullDiagnostics{Discarded: []int{-1}}
+	_, err := NewTriangulation(points, WithHullDiagnostics(&diag))
+	if !errors.Is(err, ErrInvalidHull) {
+		t.Fatalf("NewTriangulation(...) error = %v, want ErrInvalidHull", err)
+	}
+	if diag.FaceCount != 2*(len(points)-1-2) {
+		t.Errorf("diag.FaceCount = %d, want %d", diag.FaceCount, 2*(len(points)-1-2))
+	}
+	if len(diag.Discarded) != 1 || (diag.Discarded[0] != 7 && diag.Discarded[0] != 50) {
+		t.Errorf("diag.Discarded = %v, want one of the duplicates 7 and 50", diag.Discarded)
+	}
+}
+
+func TestWithHullSeed(t *testing.T) {
+	var cube s2.PointVector
+	for _, x := range []float64{-1, 1} {
+		for _, y := range []float64{-1, 1} {
+			for _, z := range []float64{-1, 1} {
+				cube = append(cube, s2.PointFromCoords(x, y, z))
+			}
+		}
+	}
+	hi, lo := cube[7], cube[0]
+
+	var want [][3]s2.Point
+	rng := rand.New(rand.NewSource(1))
+	for i := range 20 {
+		points := slices.Clone(cube)
+		rng.Shuffle(len(points), func(a, b int) { points[a], points[b] = points[b], points[a] })
+		h, l := slices.Index(points, hi), slices.Index(points, lo)
+		seed := [6]int{h, l, h, l, h, l}
+
+		var diag HullDiagnostics
+		dt, err := NewTriangulation(points, WithHullSeed(seed), WithHullDiagnostics(&diag))
+		if err != nil {
+			t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+		}
+		if diag.Extremes != seed {
+			t.Errorf("diag.Extremes = %v, want %v", diag.Extremes, seed)
+		}
+		got := trianglePoints(dt)
+		if i == 0 {
+			want = got
+			continue
+		}
+		if !slices.Equal(want, got) {
+			t.Errorf("shuffle %d triangles = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestWithHullSeed_Invalid(t *testing.T) {
+	points := utils.GenerateRandomPoints(20, 0)
+	var diag HullDiagnostics
+	if _, err := NewTriangulation(points, WithHullDiagnostics(&diag)); err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	wrong := diag.Extremes
+	wrong[0], wrong[1] = wrong[1], wrong[0]
+
+	tests := []struct {
+		name string
+		seed [6]int
+		want error
+	}{
+		{"negative", [6]int{-1, 0, 0, 0, 0, 0}, ErrOutOfRange},
+		{"too large", [6]int{20, 0, 0, 0, 0, 0}, ErrOutOfRange},
+		{"not extreme", wrong, ErrInvalidOption},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTriangulation(points, WithHullSeed(tt.seed))
+			if !errors.Is(err, tt.want) {
+				t.Errorf("NewTriangulation(..., WithHullSeed(%v)) error = %v, want %v", tt.seed,
+					err, tt.want)
+			}
+		})
+	}
+}
+
+// trianglePoints returns the triangles as vertex positions rotated to start at their smallest
+// point, in sorted order.
+func trianglePoints(dt *Triangulation) [][3]s2.Point {
+	less := func(a, b s2.Point) int {
+		return cmp.Or(cmp.Compare(a.X, b.X), cmp.Compare(a.Y, b.Y), cmp.Compare(a.Z, b.Z))
+	}
+	out := make([][3]s2.Point, len(dt.Triangles))
+	for i := range dt.Triangles {
+		p, _ := dt.TriangleVertices(i)
+		for less(p[0], p[1]) > 0 || less(p[0], p[2]) > 0 {
+			p = [3]s2.Point{p[1], p[2], p[0]}
+		}
+		out[i] = p
+	}
+	slices.SortFunc(out, func(a, b [3]s2.Point) int {
+		for k := range 3 {
+			if c := less(a[k], b[k]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return out
+}
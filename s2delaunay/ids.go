@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// TriangleID returns a stable ID for the triangle at the given index, derived from the S2 cell
+// IDs of its vertices at the configured ID level. It does not depend on triangle or vertex
+// indices, so it survives re-triangulations in which the triangle's vertices did not leave
+// their cells. It panics if tIdx is out of range.
+func (t *Triangulation) TriangleID(tIdx int) uint64 {
+	tri := t.Triangles[tIdx]
+	ids := make([]s2.CellID, 3)
+	for i, v := range tri {
+		ids[i] = s2.CellFromPoint(t.Vertices[v]).ID().Parent(t.idLevel)
+	}
+	slices.Sort(ids)
+	return hashCellIDs(ids)
+}
+
+// TriangleByID returns the index of the triangle with the given ID. The lookup map is built on
+// first use.
+// It returns an error if no triangle has the ID or if two triangles share an ID, which happens
+// when the ID level is too coarse for the vertex spacing.
+func (t *Triangulation) TriangleByID(id uint64) (int, error) {
+	t.idsOnce.Do(func() {
+		t.triangleIDs = make(map[uint64]int, len(t.Triangles))
+		for i := range t.Triangles {
+			tid := t.TriangleID(i)
+			if j, ok := t.triangleIDs[tid]; ok {
+				t.idsErr = fmt.Errorf("TriangleByID: %w: triangles %d and %d share id %#x",
+					ErrIDCollision, j, i, tid)
+				return
+			}
+			t.triangleIDs[tid] = i
+		}
+	})
+	if t.idsErr != nil {
+		return 0, t.idsErr
+	}
+	tIdx, ok := t.triangleIDs[id]
+	if !ok {
+		return 0, fmt.Errorf("TriangleByID: id %#x %w", id, ErrNotFound)
+	}
+	return tIdx, nil
+}
+
+// hashCellIDs returns the 64-bit FNV-1a hash of the cell IDs.
+func hashCellIDs(ids []s2.CellID) uint64 {
+	h := fnv.New64a()
+	var b [8]byte
+	for _, id := range ids {
+		binary.LittleEndian.PutUint64(b[:], uint64(id))
+		h.Write(b[:])
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// IDs
+
+func TestWithIDLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   int
+		wantErr bool
+	}{
+		{"level zero", 0, false},
+		{"level max", s2.MaxLevel, false},
+		{"level negative", -1, true},
+		{"level too large", s2.MaxLevel + 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &TriangulationOptions{IDLevel: defaultIDLevel}
+			err := WithIDLevel(tt.level)(opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WithIDLevel(%v) error = %v, wantErr %v", tt.level, err, tt.wantErr)
+			}
+			if err == nil && opts.IDLevel != tt.level {
+				t.Errorf("opts.IDLevel = %v, want %v", opts.IDLevel, tt.level)
+			}
+		})
+	}
+}
+
+func TestTriangulation_TriangleByID_Permuted(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(200, 0)
+	a, err := NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+
+	perm := rand.New(rand.NewSource(1)).Perm(len(vertices))
+	permuted := make(s2.PointVector, len(vertices))
+	for i, j := range perm {
+		permuted[j] = vertices[i]
+	}
+	b, err := NewTriangulation(permuted)
+	if err != nil {
+		t.Fatalf("NewTriangulation(permuted) error = %v, want nil", err)
+	}
+
+	for i, tri := range a.Triangles {
+		j, err := b.TriangleByID(a.TriangleID(i))
+		if err != nil {
+			t.Fatalf("b.TriangleByID(a.TriangleID(%d)) error = %v, want nil", i, err)
+		}
+		want := []int{perm[tri[0]], perm[tri[1]], perm[tri[2]]}
+		got := b.Triangles[j][:]
+		slices.Sort(want)
+		got = slices.Sorted(slices.Values(got))
+		if !slices.Equal(got, want) {
+			t.Errorf("b.Triangles[%d] = %v, want vertices %v", j, b.Triangles[j], want)
+		}
+	}
+}
+
+func TestTriangulation_TriangleByID_Errors(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	if _, err := dt.TriangleByID(dt.TriangleID(0) + 1); err == nil {
+		t.Errorf("dt.TriangleByID(unknown) error = nil, want non-nil")
+	}
+
+	coarse, err := NewTriangulation(utils.GenerateRandomPoints(100, 0), WithIDLevel(0))
+	if err != nil {
+		t.Fatalf("NewTriangulation(..., WithIDLevel(0)) error = %v, want nil", err)
+	}
+	if _, err := coarse.TriangleByID(coarse.TriangleID(0)); err == nil {
+		t.Errorf("coarse.TriangleByID(...) error = nil, want collision error")
+	}
+}
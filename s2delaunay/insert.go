@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// InsertPoint inserts p into the triangulation without rebuilding its hull: the triangle
+// containing p is found with Locate and split into three, then the Delaunay property is
+// restored by edge flips around the new vertex. The new vertex is appended to Vertices and its
+// index returned. The triangle and incidence arrays are rebuilt in place, which takes time
+// linear in the size of the triangulation, so triangle indices change.
+// If p is within the default Eps of a vertex of the containing triangle or its neighbors, the
+// index of that vertex is returned and the triangulation is not modified.
+// It returns an error if the triangulation is partial or has no triangles, or a *VertexError
+// wrapping ErrInvalidVertex if p has a non-finite component, is the zero vector or is not unit
+// length, in which case the triangulation is not modified.
+func (t *Triangulation) InsertPoint(p s2.Point) (int, error) {
+	if err := checkVertices(s2.PointVector{p}, unitNormTolerance); err != nil {
+		return -1, fmt.Errorf("InsertPoint: %w", err)
+	}
+	if t.Partial {
+		return -1, fmt.Errorf("InsertPoint: %w: triangulation is partial", ErrInvalidMesh)
+	}
+	if len(t.Triangles) == 0 {
+		return -1, fmt.Errorf("InsertPoint: %w: no triangles", ErrNotFound)
+	}
+
+	tIdx := t.locate(p, t.jumpStart(p), nil)
+	nearby := []int{tIdx}
+	for _, a := range t.triangleAdjacency()[tIdx] {
+		if a >= 0 {
+			nearby = append(nearby, a)
+		}
+	}
+	for _, a := range nearby {
+		for _, v := range t.Triangles[a] {
+			if t.Vertices[v].Distance(p) <= s1.Angle(defaultEps) {
+				return v, nil
+			}
+		}
+	}
+
+	m := newFlipMesh(t.Triangles)
+	n := len(t.Vertices)
+	tri := m.tris[tIdx]
+	m.insert(tIdx, n)
+	// The vertex slice may be shared with the caller, so it is never appended to in place.
+	vertices := append(slices.Clip(t.Vertices), p)
+	stack := [][2]int{{tri[0], tri[1]}, {tri[1], tri[2]}, {tri[2], tri[0]}}
+	if _, _, err := m.lawson(vertices, stack, -1); err != nil {
+		return -1, fmt.Errorf("InsertPoint: %w", err)
+	}
+
+	old := t.Vertices
+	t.Vertices = vertices
+	if err := t.setTriangles(m.tris); err != nil {
+		t.Vertices = old
+		return -1, fmt.Errorf("InsertPoint: %w", err)
+	}
+	return n, nil
+}
+
+// insert adds vertex n inside triangle tIdx, turning (a, b, c) into (a, b, n), (b, c, n) and
+// (c, a, n).
+func (m *flipMesh) insert(tIdx, n int) {
+	a, b, c := m.tris[tIdx][0], m.tris[tIdx][1], m.tris[tIdx][2]
+	g, h := len(m.tris), len(m.tris)+1
+	m.tris[tIdx] = Triangle{a, b, n}
+	m.tris = append(m.tris, Triangle{b, c, n}, Triangle{c, a, n})
+	for _, f := range []int{tIdx, g, h} {
+		tri := m.tris[f]
+		for j := range 3 {
+			m.setEdge(tri[j], tri[(j+1)%3], f)
+		}
+	}
+}
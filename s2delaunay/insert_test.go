@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// Insert
+
+func TestInsertPoint(t *testing.T) {
+	points := utils.GenerateRandomPoints(200, 0)
+	dt, err := NewTriangulation(points[:100])
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	for i, p := range points[100:] {
+		n, err := dt.InsertPoint(p)
+		if err != nil {
+			t.Fatalf("insert %d: dt.InsertPoint(...) error = %v, want nil", i, err)
+		}
+		if n != 100+i || dt.Vertices[n] != p {
+			t.Fatalf("insert %d: dt.InsertPoint(...) = %d, want %d", i, n, 100+i)
+		}
+		if err := dt.checkStructure(); err != nil {
+			t.Fatalf("insert %d: dt.checkStructure() error = %v, want nil", i, err)
+		}
+	}
+	if _, err := dt.MakeDelaunay(0); err != nil {
+		t.Fatalf("triangulation is not Delaunay after insertions: %v", err)
+	}
+
+	want, err := NewTriangulation(points)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	if !slices.Equal(sortedTriangles(dt.Triangles), sortedTriangles(want.Triangles)) {
+		t.Errorf("triangles after insertions differ from NewTriangulation of all points")
+	}
+}
+
+func TestInsertPoint_Coincident(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	tris := slices.Clone(dt.Triangles)
+	p := s2.Point{Vector: dt.Vertices[7].Add(s2.Ortho(dt.Vertices[7]).Mul(1e-14)).Normalize()}
+	n, err := dt.InsertPoint(p)
+	if err != nil || n != 7 {
+		t.Errorf("dt.InsertPoint(near vertex 7) = %d, %v, want 7, nil", n, err)
+	}
+	if len(dt.Vertices) != 100 || !slices.Equal(dt.Triangles, tris) {
+		t.Errorf("dt.InsertPoint(near vertex 7) modified the triangulation")
+	}
+
+	if _, err := (&Triangulation{Partial: true}).InsertPoint(p); !errors.Is(err,
+		ErrInvalidMesh) {
+		t.Errorf("partial.InsertPoint(...) error = %v, want %v", err, ErrInvalidMesh)
+	}
+	if _, err := new(Triangulation).InsertPoint(p); !errors.Is(err, ErrNotFound) {
+		t.Errorf("empty.InsertPoint(...) error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestInsertPoint_InvalidVertex(t *testing.T) {
+	tests := []struct {
+		name string
+		p    s2.Point
+	}{
+		{"non-unit", s2.Point{Vector: s2.PointFromCoords(1, 2, 3).Mul(2)}},
+		{"nan", s2.Point{Vector: r3.Vector{X: math.NaN(), Y: 0, Z: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dt := mustNewTriangulation(t, 100)
+			numVertices := len(dt.Vertices)
+			_, err := dt.InsertPoint(tt.p)
+			var ve *VertexError
+			if !errors.As(err, &ve) || !errors.Is(err, ErrInvalidVertex) {
+				t.Fatalf("dt.InsertPoint(%v) error = %v, want VertexError", tt.p, err)
+			}
+			if len(dt.Vertices) != numVertices {
+				t.Errorf("len(dt.Vertices) = %d, want %d", len(dt.Vertices), numVertices)
+			}
+			if err := dt.Validate(0); err != nil {
+				t.Errorf("dt.Validate(0) error = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// WalkObserver is called for each triangle visited while walking toward the query point p.
+// Steps are numbered from 0, the starting triangle, and are reported in order.
+type WalkObserver func(step int, triangleIdx int, p s2.Point)
+
+// LocateOptions configures point location.
+type LocateOptions struct {
+	// WalkObserver, if set, receives every triangle visited by the walk.
+	WalkObserver WalkObserver
+}
+
+// LocateOption is a functional option type for point location configuration.
+type LocateOption func(*LocateOptions) error
+
+// WithWalkObserver reports each triangle visited during a point location walk to f.
+// Consecutive triangles share an edge. The brute-force scan used when the walk does not
+// terminate is not reported.
+func WithWalkObserver(f WalkObserver) LocateOption {
+	return func(o *LocateOptions) error {
+		o.WalkObserver = f
+		return nil
+	}
+}
+
+// Locate returns the index of the triangle containing p with jump-and-walk: the walk along the
+// triangle adjacency starts from the nearest of about T^(1/3) sampled triangles, which keeps
+// the expected walk short for large triangulations. Orientation is decided by s2.RobustSign,
+// whose symbolic perturbation places a point on an edge in exactly one of its two triangles,
+// and a vertex is located in its lowest-index incident triangle, so the result does not
+// depend on the start. The walk is capped at the number of triangles, after which all
+// triangles are scanned.
+// It returns an error if an option is invalid or the triangulation has no triangles.
+func (t *Triangulation) Locate(p s2.Point, setters ...LocateOption) (int, error) {
+	opts, err := newLocateOptions(setters)
+	if err != nil {
+		return -1, err
+	}
+	if len(t.Triangles) == 0 {
+		return -1, fmt.Errorf("Locate: %w: no triangles", ErrNotFound)
+	}
+	return t.locate(p, t.jumpStart(p), opts.WalkObserver), nil
+}
+
+// LocateAll returns the index of the triangle containing each point, like Locate. Each walk
+// after the first starts from the triangle found for the previous point, so spatially coherent
+// point orders locate fastest.
+// It returns an error if an option is invalid or the triangulation has no triangles.
+func (t *Triangulation) LocateAll(points s2.PointVector, setters ...LocateOption) ([]int, error) {
+	opts, err := newLocateOptions(setters)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.Triangles) == 0 {
+		return nil, fmt.Errorf("LocateAll: %w: no triangles", ErrNotFound)
+	}
+	out := make([]int, len(points))
+	start := 0
+	if len(points) > 0 {
+		start = t.jumpStart(points[0])
+	}
+	for i, p := range points {
+		start = t.locate(p, start, opts.WalkObserver)
+		out[i] = start
+	}
+	return out, nil
+}
+
+// newLocateOptions applies the setters to the default point location options.
+func newLocateOptions(setters []LocateOption) (LocateOptions, error) {
+	var opts LocateOptions
+	for _, set := range setters {
+		err := set(&opts)
+		if err != nil {
+			return LocateOptions{}, err
+		}
+	}
+	return opts, nil
+}
+
+// triangleAdjacency returns, for each triangle, the triangles across the edges opposite each
+// of its vertices. It is built on first use.
+func (t *Triangulation) triangleAdjacency() [][3]int {
+	t.adjacencyOnce.Do(func() {
+		adj := make([][3]int, len(t.Triangles))
+		for tIdx, tri := range t.Triangles {
+			for j := range 3 {
+				adj[tIdx][j] = t.acrossEdge(tIdx, tri[(j+1)%3])
+			}
+		}
+		t.adjacency = adj
+	})
+	return t.adjacency
+}
+
+// acrossEdge returns the triangle sharing the edge that starts at vertex v in triangle tIdx.
+// It is the triangle after tIdx in the CCW ring of triangles incident to v, or -1 if the
+// triangle is not incident to v.
+func (t *Triangulation) acrossEdge(tIdx, v int) int {
+	incident := t.IncidentTriangleIndices[t.IncidentTriangleOffsets[v]:t.IncidentTriangleOffsets[v+1]]
+	for i, it := range incident {
+		if it == tIdx {
+			return incident[(i+1)%len(incident)]
+		}
+	}
+	return -1
+}
+
+// jumpStart returns the sampled triangle whose first vertex is nearest to p, sampling about
+// T^(1/3) triangles evenly spaced in index order.
+func (t *Triangulation) jumpStart(p s2.Point) int {
+	step := max(1, len(t.Triangles)/int(math.Cbrt(float64(len(t.Triangles)))+1))
+	best, bestDot := 0, math.Inf(-1)
+	for i := 0; i < len(t.Triangles); i += step {
+		if dot := p.Dot(t.Vertices[t.Triangles[i][0]].Vector); dot > bestDot {
+			best, bestDot = i, dot
+		}
+	}
+	return best
+}
+
+// locate returns the index of the triangle containing p by walking from the start triangle.
+// The walk is bounded by the number of triangles, after which, or when it leaves a partial
+// triangulation, all triangles are scanned.
+// If observe is not nil, it receives every triangle visited by the walk.
+func (t *Triangulation) locate(p s2.Point, start int, observe WalkObserver) int {
+	adj := t.triangleAdjacency()
+	cur := start
+	for step := range len(t.Triangles) {
+		if observe != nil {
+			observe(step, cur, p)
+		}
+		tri := t.Triangles[cur]
+		next, inside := -1, true
+		for j := range 3 {
+			a, b := t.Vertices[tri[(j+1)%3]], t.Vertices[tri[(j+2)%3]]
+			if s2.RobustSign(a, b, p) == s2.Clockwise {
+				next, inside = adj[cur][j], false
+				break
+			}
+		}
+		if inside {
+			return t.onVertex(cur, p)
+		}
+		if next < 0 {
+			// The walk left a partial triangulation through its boundary.
+			break
+		}
+		cur = next
+	}
+
+	for i := range t.Triangles {
+		if t.containsPoint(i, p) {
+			return t.onVertex(i, p)
+		}
+	}
+	return cur
+}
+
+// onVertex returns the lowest-index triangle incident to the vertex of triangle tIdx equal to
+// p, or tIdx if p is not one of its vertices. s2.RobustSign does not perturb a point equal to
+// a vertex, so it would otherwise be contained in every incident triangle.
+func (t *Triangulation) onVertex(tIdx int, p s2.Point) int {
+	for _, v := range t.Triangles[tIdx] {
+		if t.Vertices[v] == p {
+			incident, _ := t.IncidentTriangles(v)
+			return slices.Min(incident)
+		}
+	}
+	return tIdx
+}
+
+// containsPoint reports whether the triangle at the given index contains p.
+func (t *Triangulation) containsPoint(tIdx int, p s2.Point) bool {
+	tri := t.Triangles[tIdx]
+	return PointInTriangle(p, t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]])
+}
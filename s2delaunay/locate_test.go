@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Locate
+
+func TestTriangleAdjacency(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	adj := dt.triangleAdjacency()
+	for tIdx, tri := range dt.Triangles {
+		for j := range 3 {
+			n := adj[tIdx][j]
+			if n < 0 {
+				t.Fatalf("adj[%d][%d] = %d, want valid triangle", tIdx, j, n)
+			}
+			a, b := tri[(j+1)%3], tri[(j+2)%3]
+			nt := dt.Triangles[n]
+			if !slices.Contains(nt[:], a) || !slices.Contains(nt[:], b) {
+				t.Errorf("adj[%d][%d] = %v does not share edge %d-%d", tIdx, j, nt, a, b)
+			}
+			if !slices.Contains(adj[n][:], tIdx) {
+				t.Errorf("adj[%d] = %v does not contain %d", n, adj[n], tIdx)
+			}
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	start := 0
+	for i, p := range utils.GenerateRandomPoints(1000, 1) {
+		got := dt.locate(p, start, nil)
+		if !dt.containsPoint(got, p) {
+			t.Errorf("dt.locate(points[%d], %d) = %d, triangle does not contain point", i, start,
+				got)
+		}
+		start = got
+	}
+	for i, p := range dt.Vertices {
+		got := dt.locate(p, 0, nil)
+		if !slices.Contains(dt.Triangles[got][:], i) {
+			t.Errorf("dt.locate(dt.Vertices[%d], 0) = %v, want triangle incident to vertex", i,
+				dt.Triangles[got])
+		}
+	}
+}
+
+func TestTriangulation_Locate(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	for i, p := range utils.GenerateRandomPoints(100, 1) {
+		got, err := dt.Locate(p)
+		if err != nil {
+			t.Fatalf("dt.Locate(points[%d]) error = %v, want nil", i, err)
+		}
+		if !dt.containsPoint(got, p) {
+			t.Errorf("dt.Locate(points[%d]) = %d, triangle does not contain point", i, got)
+		}
+	}
+
+	// Vertices and edge midpoints lie on several triangles but are located in one of them
+	// regardless of where the walk starts.
+	for _, e := range dt.Edges()[:50] {
+		mid := s2.Interpolate(0.5, dt.Vertices[e[0]], dt.Vertices[e[1]])
+		for _, p := range []s2.Point{dt.Vertices[e[0]], mid} {
+			want, err := dt.Locate(p)
+			if err != nil {
+				t.Fatalf("dt.Locate(%v) error = %v, want nil", p, err)
+			}
+			for _, start := range []int{0, len(dt.Triangles) / 2, len(dt.Triangles) - 1} {
+				if got := dt.locate(p, start, nil); got != want {
+					t.Errorf("dt.locate(%v, %d) = %d, want %d", p, start, got, want)
+				}
+			}
+		}
+	}
+
+	if _, err := new(Triangulation).Locate(dt.Vertices[0]); !errors.Is(err, ErrNotFound) {
+		t.Errorf("empty.Locate(...) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTriangulation_Locate_JumpAndWalk(t *testing.T) {
+	dt := mustNewTriangulation(t, 20000)
+	steps := 0
+	observer := func(step, _ int, _ s2.Point) {
+		steps = max(steps, step+1)
+	}
+	points := utils.GenerateRandomPoints(100, 1)
+	total := 0
+	for _, p := range points {
+		steps = 0
+		if _, err := dt.Locate(p, WithWalkObserver(observer)); err != nil {
+			t.Fatalf("dt.Locate(...) error = %v, want nil", err)
+		}
+		total += steps
+	}
+	if mean := total / len(points); mean > 60 {
+		t.Errorf("mean walk length = %d triangles, want at most 60", mean)
+	}
+}
+
+func TestWithWalkObserver(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	points := utils.GenerateRandomPoints(200, 1)
+
+	var path []int
+	var queries s2.PointVector
+	observer := func(step, tIdx int, p s2.Point) {
+		if step == 0 {
+			path = path[:0]
+			queries = append(queries, p)
+		}
+		if step != len(path) {
+			t.Fatalf("observer step = %d, want %d", step, len(path))
+		}
+		if len(path) > 0 && !sharesEdge(dt.Triangles[path[len(path)-1]], dt.Triangles[tIdx]) {
+			t.Fatalf("triangles %d and %d visited in sequence do not share an edge",
+				path[len(path)-1], tIdx)
+		}
+		path = append(path, tIdx)
+	}
+
+	got, err := dt.LocateAll(points, WithWalkObserver(observer))
+	if err != nil {
+		t.Fatalf("dt.LocateAll(...) error = %v, want nil", err)
+	}
+	if len(queries) != len(points) {
+		t.Fatalf("observer saw %d walks, want %d", len(queries), len(points))
+	}
+	for i, p := range points {
+		if queries[i] != p {
+			t.Errorf("walk %d reported point %v, want %v", i, queries[i], p)
+		}
+		if !dt.containsPoint(got[i], p) {
+			t.Errorf("dt.LocateAll(...)[%d] = %d, triangle does not contain point", i, got[i])
+		}
+	}
+
+	path = nil
+	last, err := dt.Locate(points[0], WithWalkObserver(observer))
+	if err != nil {
+		t.Fatalf("dt.Locate(...) error = %v, want nil", err)
+	}
+	if start := dt.jumpStart(points[0]); path[0] != start || path[len(path)-1] != last {
+		t.Errorf("walk path = %v, want from %d to %d", path, start, last)
+	}
+}
+
+func sharesEdge(a, b [3]int) bool {
+	shared := 0
+	for _, v := range a {
+		if slices.Contains(b[:], v) {
+			shared++
+		}
+	}
+	return shared == 2
+}
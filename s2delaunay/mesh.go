@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// FromMesh creates a triangulation from a precomputed spherical mesh, such as an icosphere,
+// instead of computing the convex hull of the vertices. The triangles must form a closed,
+// manifold triangulation of the sphere: it must satisfy the Euler formula V - E + F = 2, every
+// edge must be shared by exactly two triangles, the triangles around every vertex must form a
+// single fan, and every triangle must be CCW when looking out of the sphere. Under
+// WithFixOrientation clockwise triangles are flipped instead of rejected.
+// The Delaunay property is not required, so circumcircle based queries such as TrianglesInCap
+// may behave differently than on triangulations built by NewTriangulation. MakeDelaunay
+// restores it.
+// The triangulation stores a copy of the vertices unless WithBorrowInput is given, and does not
+// retain triangles.
+// It returns an error if there are fewer than 4 vertices or the triangles are not a valid mesh.
+func FromMesh(vertices s2.PointVector, triangles []Triangle, setters ...TriangulationOption) (
+	*Triangulation, error) {
+	opts := TriangulationOptions{
+		Eps:     defaultEps,
+		IDLevel: defaultIDLevel,
+	}
+	for _, set := range setters {
+		err := set(&opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !opts.BorrowInput {
+		vertices = slices.Clone(vertices)
+	}
+	numVertices := len(vertices)
+	if numVertices < 4 {
+		return nil, fmt.Errorf("FromMesh: %w", ErrInsufficientVertices)
+	}
+	if len(triangles) != 2*numVertices-4 {
+		return nil, fmt.Errorf("FromMesh: %w: %d triangles for %d vertices, want %d",
+			ErrInvalidMesh, len(triangles), numVertices, 2*numVertices-4)
+	}
+	for i, tri := range triangles {
+		for _, v := range tri {
+			if v < 0 || v >= numVertices {
+				return nil, fmt.Errorf("FromMesh: triangle %d vertex %d %w [0 %d)", i, v,
+					ErrOutOfRange, numVertices)
+			}
+		}
+		if tri[0] == tri[1] || tri[1] == tri[2] || tri[2] == tri[0] {
+			return nil, fmt.Errorf("FromMesh: %w: triangle %d has repeated vertices %v",
+				ErrInvalidMesh, i, tri)
+		}
+	}
+
+	tris := make([]Triangle, len(triangles))
+	copy(tris, triangles)
+	edges, err := meshEdges(tris)
+	if err != nil {
+		return nil, err
+	}
+	// With F = 2V - 4 and every edge shared by two triangles, E = 3F/2 = 3V - 6 and the Euler
+	// formula holds.
+	if opts.FixOrientation {
+		if err := orientMesh(tris, edges, vertices); err != nil {
+			return nil, err
+		}
+	}
+	for i, tri := range tris {
+		if meshOrientation(tri, vertices) <= 0 {
+			return nil, fmt.Errorf("FromMesh: %w: triangle %d %v is not CCW", ErrInvalidMesh, i,
+				tri)
+		}
+	}
+
+	indices := make([]int, 0, 3*len(tris))
+	for _, tri := range tris {
+		indices = append(indices, tri[:]...)
+	}
+	t, err := newTriangulation(vertices, indices, opts.IDLevel)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.checkManifold(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// meshEdges maps every undirected edge of the triangles, keyed by its sorted endpoints, to the
+// two triangles sharing it.
+// It returns an error if an edge is not shared by exactly two triangles.
+func meshEdges(tris []Triangle) (map[[2]int][]int, error) {
+	edges := make(map[[2]int][]int, 3*len(tris)/2)
+	for i, tri := range tris {
+		for j := range 3 {
+			a, b := tri[j], tri[(j+1)%3]
+			key := [2]int{min(a, b), max(a, b)}
+			edges[key] = append(edges[key], i)
+		}
+	}
+	for e, faces := range edges {
+		if len(faces) != 2 {
+			return nil, fmt.Errorf("FromMesh: %w: edge %v shared by %d triangles, want 2",
+				ErrInvalidMesh, e, len(faces))
+		}
+	}
+	return edges, nil
+}
+
+// orientMesh flips triangles so that every edge is traversed in opposite directions by its two
+// triangles, spreading the orientation of each connected component from its first triangle, and
+// then flips whole components whose total orientation is clockwise.
+// It returns an error if the mesh is not orientable.
+func orientMesh(tris []Triangle, edges map[[2]int][]int, vertices s2.PointVector) error {
+	component := make([]int, len(tris))
+	for i := range component {
+		component[i] = -1
+	}
+	var volumes []float64
+	for start := range tris {
+		if component[start] >= 0 {
+			continue
+		}
+		c := len(volumes)
+		volumes = append(volumes, 0)
+		component[start] = c
+		queue := []int{start}
+		for len(queue) > 0 {
+			i := queue[0]
+			queue = queue[1:]
+			volumes[c] += meshOrientation(tris[i], vertices)
+			for j := range 3 {
+				a, b := tris[i][j], tris[i][(j+1)%3]
+				faces := edges[[2]int{min(a, b), max(a, b)}]
+				other := faces[0]
+				if other == i {
+					other = faces[1]
+				}
+				same := hasDirectedEdge(tris[other], a, b)
+				if component[other] >= 0 {
+					if same {
+						return fmt.Errorf("FromMesh: %w: mesh is not orientable", ErrInvalidMesh)
+					}
+					continue
+				}
+				if same {
+					tris[other][1], tris[other][2] = tris[other][2], tris[other][1]
+				}
+				component[other] = c
+				queue = append(queue, other)
+			}
+		}
+	}
+	for i, c := range component {
+		if volumes[c] < 0 {
+			tris[i][1], tris[i][2] = tris[i][2], tris[i][1]
+		}
+	}
+	return nil
+}
+
+// hasDirectedEdge reports whether the triangle traverses the edge from a to b.
+func hasDirectedEdge(tri [3]int, a, b int) bool {
+	for j := range 3 {
+		if tri[j] == a && tri[(j+1)%3] == b {
+			return true
+		}
+	}
+	return false
+}
+
+// meshOrientation returns a value that is positive if the triangle is CCW when looking out of
+// the sphere and negative if it is clockwise.
+func meshOrientation(tri [3]int, vertices s2.PointVector) float64 {
+	p0, p1, p2 := vertices[tri[0]], vertices[tri[1]], vertices[tri[2]]
+	return p1.Sub(p0.Vector).Cross(p2.Sub(p0.Vector)).Dot(p0.Vector)
+}
+
+// checkManifold verifies that the sorted incident triangles of every vertex form a single closed
+// fan, which fails for vertices where several sheets of the mesh touch, and that the triangles
+// are connected. Together with the Euler formula this ensures the mesh is a single sphere.
+func (t *Triangulation) checkManifold() error {
+	for v := range t.Vertices {
+		incident, err := t.IncidentTriangles(v)
+		if err != nil {
+			return err
+		}
+		if len(incident) == 0 {
+			return fmt.Errorf("FromMesh: %w: vertex %d has no triangles", ErrInvalidMesh, v)
+		}
+		for k, tIdx := range incident {
+			next, err := NextVertex(t.Triangles[tIdx], v)
+			if err != nil {
+				return err
+			}
+			prev, err := PrevVertex(t.Triangles[incident[(k+1)%len(incident)]], v)
+			if err != nil {
+				return err
+			}
+			if next != prev {
+				return fmt.Errorf("FromMesh: %w: triangles around vertex %d do not form a fan",
+					ErrInvalidMesh, v)
+			}
+		}
+	}
+
+	adj := t.triangleAdjacency()
+	seen := make([]bool, len(t.Triangles))
+	seen[0] = true
+	queue := []int{0}
+	for len(queue) > 0 {
+		tIdx := queue[0]
+		queue = queue[1:]
+		for _, nb := range adj[tIdx] {
+			if nb >= 0 && !seen[nb] {
+				seen[nb] = true
+				queue = append(queue, nb)
+			}
+		}
+	}
+	for tIdx, ok := range seen {
+		if !ok {
+			return fmt.Errorf("FromMesh: %w: triangle %d is not connected to triangle 0",
+				ErrInvalidMesh, tIdx)
+		}
+	}
+	return nil
+}
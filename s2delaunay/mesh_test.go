@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Mesh
+
+func TestFromMesh(t *testing.T) {
+	src := mustNewTriangulation(t, 50)
+	flip := func(tris []Triangle, indices ...int) []Triangle {
+		out := make([]Triangle, len(tris))
+		copy(out, tris)
+		for _, i := range indices {
+			out[i][1], out[i][2] = out[i][2], out[i][1]
+		}
+		return out
+	}
+	all := make([]int, len(src.Triangles))
+	for i := range all {
+		all[i] = i
+	}
+
+	tests := []struct {
+		name      string
+		triangles []Triangle
+		setters   []TriangulationOption
+		wantErr   error
+	}{
+		{"valid", src.Triangles, nil, nil},
+		{"one clockwise", flip(src.Triangles, 3), nil, ErrInvalidMesh},
+		{"one clockwise fixed", flip(src.Triangles, 3), []TriangulationOption{
+			WithFixOrientation()}, nil},
+		{"all clockwise fixed", flip(src.Triangles, all...), []TriangulationOption{
+			WithFixOrientation()}, nil},
+		{"missing triangle", src.Triangles[1:], nil, ErrInvalidMesh},
+		{"repeated vertex", append([]Triangle{{0, 0, 1}}, src.Triangles[1:]...), nil,
+			ErrInvalidMesh},
+		{"out of range", append([]Triangle{{0, 1, 50}}, src.Triangles[1:]...), nil,
+			ErrOutOfRange},
+		{"duplicate triangle", append([]Triangle{src.Triangles[1]}, src.Triangles[1:]...), nil,
+			ErrInvalidMesh},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromMesh(src.Vertices, tt.triangles, tt.setters...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromMesh(...) error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(src.Triangles, got.Triangles); diff != "" {
+				t.Errorf("got.Triangles mismatch (-want +got):\n%s", diff)
+			}
+			diff := cmp.Diff(src.IncidentTriangleIndices, got.IncidentTriangleIndices)
+			if diff != "" {
+				t.Errorf("got.IncidentTriangleIndices mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := FromMesh(src.Vertices[:3], nil); !errors.Is(err, ErrInsufficientVertices) {
+		t.Errorf("FromMesh(3 vertices) error = %v, want %v", err, ErrInsufficientVertices)
+	}
+}
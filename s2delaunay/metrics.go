@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"time"
+)
+
+// BuildMetrics reports where NewTriangulation spent its time and memory.
+type BuildMetrics struct {
+	// Vertices is the number of input vertices.
+	Vertices int
+	// Triangles is the number of triangles built.
+	Triangles int
+
+	// Hull is the time spent computing the convex hull.
+	Hull time.Duration
+	// Orientation is the time spent orienting the triangles CCW.
+	Orientation time.Duration
+	// IncidenceBuild is the time spent filling the incidence arrays.
+	IncidenceBuild time.Duration
+	// IncidenceSort is the time spent sorting each incident ring CCW.
+	IncidenceSort time.Duration
+	// Total is the time spent in the whole build, including option handling and validation.
+	Total time.Duration
+
+	// Allocs is the number of heap allocations made during the build.
+	Allocs uint64
+	// AllocBytes is the number of heap bytes allocated during the build.
+	AllocBytes uint64
+}
+
+// WithMetrics fills m with the phase durations, allocation counts and sizes of the build.
+// Allocations are measured process-wide with runtime.ReadMemStats, so they are best-effort
+// when other goroutines allocate concurrently. The metrics are filled on the error path too,
+// with the phases that did not run left at zero.
+func WithMetrics(m *BuildMetrics) TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		if m == nil {
+			return &OptionError{Name: "WithMetrics",
+				Err: fmt.Errorf("%w: m must not be nil", ErrInvalidOption)}
+		}
+		o.Metrics = m
+		return nil
+	}
+}
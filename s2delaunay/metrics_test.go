@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/2dChan/s2voronoi/utils"
+)
+
+// Metrics
+
+func TestWithMetrics(t *testing.T) {
+	points := utils.GenerateRandomPoints(10000, 0)
+	var m BuildMetrics
+	dt, err := NewTriangulation(points, WithMetrics(&m))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	if m.Vertices != len(points) || m.Triangles != len(dt.Triangles) {
+		t.Errorf("m.Vertices, m.Triangles = %d, %d, want %d, %d", m.Vertices, m.Triangles,
+			len(points), len(dt.Triangles))
+	}
+	phases := []struct {
+		name string
+		d    time.Duration
+	}{
+		{"Hull", m.Hull},
+		{"Orientation", m.Orientation},
+		{"IncidenceBuild", m.IncidenceBuild},
+		{"IncidenceSort", m.IncidenceSort},
+	}
+	for _, p := range phases {
+		if p.d <= 0 {
+			t.Errorf("m.%s = %v, want positive", p.name, p.d)
+		}
+	}
+	sum := m.Hull + m.Orientation + m.IncidenceBuild + m.IncidenceSort
+	if sum > m.Total || sum < m.Total/2 {
+		t.Errorf("phase sum = %v, want in [%v, %v]", sum, m.Total/2, m.Total)
+	}
+	if m.Allocs == 0 || m.AllocBytes == 0 {
+		t.Errorf("m.Allocs, m.AllocBytes = %d, %d, want positive", m.Allocs, m.AllocBytes)
+	}
+
+	if err := WithMetrics(nil)(&TriangulationOptions{}); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("WithMetrics(nil) error = %v, want ErrInvalidOption", err)
+	}
+}
+
+func TestWithMetrics_Error(t *testing.T) {
+	points := utils.GenerateRandomPoints(3, 0)
+	m := BuildMetrics{Triangles: -1}
+	if _, err := NewTriangulation(points, WithMetrics(&m)); err == nil {
+		t.Fatalf("NewTriangulation(...) error = nil, want non-nil")
+	}
+	if m.Vertices != 3 || m.Triangles != 0 || m.Total <= 0 {
+		t.Errorf("m = %+v, want Vertices 3, Triangles 0 and positive Total", m)
+	}
+}
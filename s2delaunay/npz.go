@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/2dChan/s2voronoi/internal/npy"
+)
+
+// WriteNPZ writes the triangulation to w as a NumPy .npz archive containing vertices.npy
+// (float64, n×3), triangles.npy (int32, m×3), incident_indices.npy and incident_offsets.npy
+// (int32). Load it with numpy.load.
+// It returns an error if an index does not fit in int32 or writing fails.
+func (t *Triangulation) WriteNPZ(w io.Writer) error {
+	flat := make([]int, 0, 3*len(t.Triangles))
+	for _, tri := range t.Triangles {
+		flat = append(flat, tri[:]...)
+	}
+	triangles, err := npy.Int32s(flat)
+	if err != nil {
+		return fmt.Errorf("WriteNPZ: triangles: %w", err)
+	}
+	indices, err := npy.Int32s(t.IncidentTriangleIndices)
+	if err != nil {
+		return fmt.Errorf("WriteNPZ: incident indices: %w", err)
+	}
+	offsets, err := npy.Int32s(t.IncidentTriangleOffsets)
+	if err != nil {
+		return fmt.Errorf("WriteNPZ: incident offsets: %w", err)
+	}
+
+	return npy.WriteNPZ(w, []npy.Array{
+		{Name: "vertices", Shape: []int{len(t.Vertices), 3}, Float64: npy.Points(t.Vertices)},
+		{Name: "triangles", Shape: []int{len(t.Triangles), 3}, Int32: triangles},
+		{Name: "incident_indices", Shape: []int{len(indices)}, Int32: indices},
+		{Name: "incident_offsets", Shape: []int{len(offsets)}, Int32: offsets},
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// NPZ
+
+func TestTriangulation_WriteNPZ(t *testing.T) {
+	tr := mustNewTriangulation(t, 100)
+	var buf bytes.Buffer
+	if err := tr.WriteNPZ(&buf); err != nil {
+		t.Fatalf("tr.WriteNPZ(...) error = %v, want nil", err)
+	}
+	arrays := readNPZ(t, buf.Bytes())
+
+	var vertices []float64
+	for _, p := range tr.Vertices {
+		vertices = append(vertices, p.X, p.Y, p.Z)
+	}
+	var triangles []int32
+	for _, tri := range tr.Triangles {
+		triangles = append(triangles, int32(tri[0]), int32(tri[1]), int32(tri[2]))
+	}
+	indices, offsets := toInt32(tr.IncidentTriangleIndices), toInt32(tr.IncidentTriangleOffsets)
+	want := map[string]npyArray{
+		"vertices":         {Shape: []int{100, 3}, Float64: vertices},
+		"triangles":        {Shape: []int{len(tr.Triangles), 3}, Int32: triangles},
+		"incident_indices": {Shape: []int{len(indices)}, Int32: indices},
+		"incident_offsets": {Shape: []int{len(offsets)}, Int32: offsets},
+	}
+	if diff := cmp.Diff(want, arrays); diff != "" {
+		t.Errorf("readNPZ(tr.WriteNPZ(...)) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// Helpers
+
+type npyArray struct {
+	Shape   []int
+	Float64 []float64
+	Int32   []int32
+}
+
+var npyHeaderRe = regexp.MustCompile(
+	`^\{'descr': '([^']*)', 'fortran_order': (True|False), 'shape': \(([^)]*)\), \} *\n$`)
+
+// readNPZ is a minimal .npz reader supporting the C-ordered <f8 and <i4 arrays WriteNPZ emits.
+func readNPZ(t *testing.T, data []byte) map[string]npyArray {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader(...) error = %v, want nil", err)
+	}
+	arrays := make(map[string]npyArray)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("f.Open() error = %v, want nil", err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("io.ReadAll(%s) error = %v, want nil", f.Name, err)
+		}
+
+		if len(b) < 10 || string(b[:6]) != "\x93NUMPY" || b[6] != 1 || b[7] != 0 {
+			t.Fatalf("%s: bad magic or version % x", f.Name, b[:min(len(b), 8)])
+		}
+		hlen := int(binary.LittleEndian.Uint16(b[8:10]))
+		if (10+hlen)%64 != 0 {
+			t.Errorf("%s: data offset %d not 64-byte aligned", f.Name, 10+hlen)
+		}
+		m := npyHeaderRe.FindStringSubmatch(string(b[10 : 10+hlen]))
+		if m == nil {
+			t.Fatalf("%s: malformed header %q", f.Name, b[10:10+hlen])
+		}
+		if m[2] != "False" {
+			t.Errorf("%s: fortran_order = %s, want False", f.Name, m[2])
+		}
+		var a npyArray
+		size := 1
+		for _, s := range strings.Split(m[3], ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				t.Fatalf("%s: bad shape %q", f.Name, m[3])
+			}
+			a.Shape = append(a.Shape, n)
+			size *= n
+		}
+
+		body := bytes.NewReader(b[10+hlen:])
+		switch m[1] {
+		case "<f8":
+			a.Float64 = make([]float64, size)
+			err = binary.Read(body, binary.LittleEndian, a.Float64)
+		case "<i4":
+			a.Int32 = make([]int32, size)
+			err = binary.Read(body, binary.LittleEndian, a.Int32)
+		default:
+			t.Fatalf("%s: unsupported descr %q", f.Name, m[1])
+		}
+		if err != nil || body.Len() != 0 {
+			t.Fatalf("%s: data does not match shape %v", f.Name, a.Shape)
+		}
+		arrays[strings.TrimSuffix(f.Name, ".npy")] = a
+	}
+	return arrays
+}
+
+func toInt32(s []int) []int32 {
+	out := make([]int32, len(s))
+	for i, v := range s {
+		out[i] = int32(v)
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// WriteOBJ writes the triangulation to w as a Wavefront OBJ mesh for viewers such as Blender
+// or MeshLab: a "v x y z" line per vertex with its unit-sphere coordinates, followed by an
+// "f" line per triangle with 1-based vertex indices. Triangles keep their CCW order, so face
+// normals point out of the sphere.
+func (t *Triangulation) WriteOBJ(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range t.Vertices {
+		fmt.Fprintf(bw, "v %v %v %v\n", v.X, v.Y, v.Z)
+	}
+	for _, tri := range t.Triangles {
+		fmt.Fprintf(bw, "f %d %d %d\n", tri[0]+1, tri[1]+1, tri[2]+1)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/internal/objtest"
+)
+
+// OBJ
+
+func TestWriteOBJ(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	var buf bytes.Buffer
+	if err := dt.WriteOBJ(&buf); err != nil {
+		t.Fatalf("dt.WriteOBJ(...) error = %v, want nil", err)
+	}
+
+	vertices, faces := objtest.Parse(t, &buf)
+	if len(vertices) != len(dt.Vertices) || len(faces) != len(dt.Triangles) {
+		t.Fatalf("got %d vertices and %d faces, want %d and %d", len(vertices), len(faces),
+			len(dt.Vertices), len(dt.Triangles))
+	}
+	for i, v := range vertices {
+		if v != dt.Vertices[i].Vector {
+			t.Errorf("vertex %d = %v, want %v", i, v, dt.Vertices[i])
+		}
+	}
+	for i, f := range faces {
+		if want := dt.Triangles[i]; f != [3]int{want[0] + 1, want[1] + 1, want[2] + 1} {
+			t.Errorf("face %d = %v, want 1-based %v", i, f, want)
+		}
+		a, b, c := vertices[f[0]-1], vertices[f[1]-1], vertices[f[2]-1]
+		if n := b.Sub(a).Cross(c.Sub(a)); n.Dot(a) <= 0 {
+			t.Errorf("face %d normal %v points into the sphere", i, n)
+		}
+	}
+}
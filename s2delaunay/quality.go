@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// QualityMetric selects the measure used to rate triangle shape quality.
+type QualityMetric int
+
+const (
+	// MinAngle is the smallest interior spherical angle of the triangle in radians.
+	// Smaller values are worse.
+	MinAngle QualityMetric = iota
+	// RadiusEdgeRatio is the circumradius of the triangle's circumscribed cap divided by its
+	// shortest edge, both measured as angles on the sphere. Larger values are worse.
+	RadiusEdgeRatio
+	// AspectRatio is the longest edge divided by the shortest edge, both measured as angles on
+	// the sphere. Larger values are worse.
+	AspectRatio
+)
+
+// String returns the name of the metric.
+func (m QualityMetric) String() string {
+	switch m {
+	case MinAngle:
+		return "MinAngle"
+	case RadiusEdgeRatio:
+		return "RadiusEdgeRatio"
+	case AspectRatio:
+		return "AspectRatio"
+	}
+	return fmt.Sprintf("QualityMetric(%d)", int(m))
+}
+
+// TriangleQuality returns the value of the metric for the triangle at the given index.
+// Degenerate triangles with a zero-length edge rate as +Inf for ratio metrics.
+// It returns an error if the triangle index is out of bounds or the metric is unknown.
+func (t *Triangulation) TriangleQuality(tIdx int, metric QualityMetric) (float64, error) {
+	p, err := t.TriangleVertices(tIdx)
+	if err != nil {
+		return 0, err
+	}
+	q, ok := triangleQuality(p, metric)
+	if !ok {
+		return 0, fmt.Errorf("TriangleQuality: unknown metric %v", metric)
+	}
+	return q, nil
+}
+
+// Quality holds the shape measures of a triangle, with lengths and angles measured on the
+// sphere rather than along chords.
+type Quality struct {
+	// MinAngle is the smallest interior spherical angle.
+	MinAngle s1.Angle
+	// Circumradius is the angular radius of the circumscribed cap, or +Inf for a triangle
+	// with coincident vertices.
+	Circumradius s1.Angle
+	// EdgeRatio is the longest edge divided by the shortest edge, or +Inf for a triangle with
+	// a zero-length edge.
+	EdgeRatio float64
+}
+
+// Quality returns all shape measures of the triangle at the given index, the combined form of
+// TriangleQuality.
+// It returns an error if the triangle index is out of bounds.
+func (t *Triangulation) Quality(tIdx int) (Quality, error) {
+	p, err := t.TriangleVertices(tIdx)
+	if err != nil {
+		return Quality{}, fmt.Errorf("Quality: %w", err)
+	}
+	minAngle, _ := triangleQuality(p, MinAngle)
+	edgeRatio, _ := triangleQuality(p, AspectRatio)
+	q := Quality{MinAngle: s1.Angle(minAngle), Circumradius: s1.InfAngle(),
+		EdgeRatio: edgeRatio}
+	if c, err := Circumcenter(p[0], p[1], p[2]); err == nil {
+		q.Circumradius = c.Distance(p[0])
+	}
+	return q, nil
+}
+
+// WorstTriangle returns the index of the triangle with the smallest MinAngle, the lowest such
+// index on ties, or -1 if there are no triangles.
+func (t *Triangulation) WorstTriangle() int {
+	worst := t.WorstTriangles(1, MinAngle)
+	if len(worst) == 0 {
+		return -1
+	}
+	return worst[0]
+}
+
+// WorstTriangles returns the indices of the n worst triangles under the metric, worst first.
+// Ties are broken by ascending triangle index. If n exceeds the number of triangles, all
+// triangles are returned; n <= 0 or an unknown metric yields nil.
+func (t *Triangulation) WorstTriangles(n int, metric QualityMetric) []int {
+	if n <= 0 {
+		return nil
+	}
+	numTriangles := len(t.Triangles)
+	scores := make([]float64, numTriangles)
+	for i := range numTriangles {
+		p, err := t.TriangleVertices(i)
+		if err != nil {
+			return nil
+		}
+		q, ok := triangleQuality(p, metric)
+		if !ok {
+			return nil
+		}
+		if metric == MinAngle {
+			q = -q
+		}
+		scores[i] = q
+	}
+
+	indices := make([]int, numTriangles)
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return scores[indices[a]] > scores[indices[b]]
+	})
+	if n > numTriangles {
+		n = numTriangles
+	}
+	return indices[:n]
+}
+
+// LocalFeatureSize returns, for each vertex, the angular length in radians of its shortest
+// incident edge, a local estimate of the point spacing around the vertex.
+func (t *Triangulation) LocalFeatureSize() []float64 {
+	sizes := make([]float64, len(t.Vertices))
+	for i := range sizes {
+		sizes[i] = math.Inf(1)
+	}
+	for _, tri := range t.Triangles {
+		for j := range 3 {
+			a, b := tri[j], tri[(j+1)%3]
+			l := t.Vertices[a].Distance(t.Vertices[b]).Radians()
+			sizes[a] = min(sizes[a], l)
+			sizes[b] = min(sizes[b], l)
+		}
+	}
+	return sizes
+}
+
+// triangleQuality computes the metric for a triangle, reporting false for unknown metrics.
+func triangleQuality(p [3]s2.Point, metric QualityMetric) (float64, bool) {
+	switch metric {
+	case MinAngle:
+		a0 := s2.Angle(p[2], p[0], p[1])
+		a1 := s2.Angle(p[0], p[1], p[2])
+		a2 := s2.Angle(p[1], p[2], p[0])
+		return min(a0, a1, a2).Radians(), true
+	case RadiusEdgeRatio:
+		shortest, _ := edgeLengthRange(p)
+		c, err := Circumcenter(p[0], p[1], p[2])
+		if err != nil {
+			return math.Inf(1), true
+		}
+		return ratio(c.Distance(p[0]), shortest), true
+	case AspectRatio:
+		shortest, longest := edgeLengthRange(p)
+		return ratio(longest, shortest), true
+	}
+	return 0, false
+}
+
+// edgeLengthRange returns the shortest and longest edge lengths of a triangle.
+func edgeLengthRange(p [3]s2.Point) (s1.Angle, s1.Angle) {
+	e0 := p[0].Distance(p[1])
+	e1 := p[1].Distance(p[2])
+	e2 := p[2].Distance(p[0])
+	return min(e0, e1, e2), max(e0, e1, e2)
+}
+
+// ratio divides two angles, returning +Inf for a zero denominator.
+func ratio(num, den s1.Angle) float64 {
+	if den == 0 {
+		return math.Inf(1)
+	}
+	return float64(num / den)
+}
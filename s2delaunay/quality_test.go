@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Quality
+
+func TestTriangleQuality_Tetrahedron(t *testing.T) {
+	dt := mustNewTetrahedron(t)
+	// Every face of the spherical regular tetrahedron has interior angles of 2π/3.
+	for i := range dt.Triangles {
+		got, err := dt.TriangleQuality(i, MinAngle)
+		if err != nil {
+			t.Fatalf("dt.TriangleQuality(%d, MinAngle) error = %v, want nil", i, err)
+		}
+		if want := 2 * math.Pi / 3; math.Abs(got-want) > 1e-9 {
+			t.Errorf("dt.TriangleQuality(%d, MinAngle) = %v, want %v", i, got, want)
+		}
+
+		got, err = dt.TriangleQuality(i, AspectRatio)
+		if err != nil {
+			t.Fatalf("dt.TriangleQuality(%d, AspectRatio) error = %v, want nil", i, err)
+		}
+		if math.Abs(got-1) > 1e-9 {
+			t.Errorf("dt.TriangleQuality(%d, AspectRatio) = %v, want 1", i, got)
+		}
+	}
+}
+
+func TestQuality_Tetrahedron(t *testing.T) {
+	dt := mustNewTetrahedron(t)
+	// The circumcenter of a face is the antipode of the opposite vertex, at acos(1/3) from
+	// the face vertices.
+	wantRadius := math.Acos(1.0 / 3)
+	for i := range dt.Triangles {
+		got, err := dt.Quality(i)
+		if err != nil {
+			t.Fatalf("dt.Quality(%d) error = %v, want nil", i, err)
+		}
+		if want := 2 * math.Pi / 3; math.Abs(got.MinAngle.Radians()-want) > 1e-9 {
+			t.Errorf("dt.Quality(%d).MinAngle = %v, want %v", i, got.MinAngle.Radians(), want)
+		}
+		if math.Abs(got.Circumradius.Radians()-wantRadius) > 1e-9 {
+			t.Errorf("dt.Quality(%d).Circumradius = %v, want %v", i,
+				got.Circumradius.Radians(), wantRadius)
+		}
+		if math.Abs(got.EdgeRatio-1) > 1e-9 {
+			t.Errorf("dt.Quality(%d).EdgeRatio = %v, want 1", i, got.EdgeRatio)
+		}
+	}
+	if _, err := dt.Quality(len(dt.Triangles)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("dt.Quality(%d) error = %v, want %v", len(dt.Triangles), err, ErrOutOfRange)
+	}
+	// All faces tie, so the lowest index wins.
+	if got := dt.WorstTriangle(); got != 0 {
+		t.Errorf("dt.WorstTriangle() = %d, want 0", got)
+	}
+	if got := (&Triangulation{}).WorstTriangle(); got != -1 {
+		t.Errorf("empty WorstTriangle() = %d, want -1", got)
+	}
+}
+
+func TestWorstTriangle(t *testing.T) {
+	dt := mustNewTriangulation(t, 300)
+	worst := dt.WorstTriangle()
+	q, err := dt.Quality(worst)
+	if err != nil {
+		t.Fatalf("dt.Quality(%d) error = %v, want nil", worst, err)
+	}
+	for i := range dt.Triangles {
+		other, _ := dt.Quality(i)
+		if other.MinAngle < q.MinAngle {
+			t.Fatalf("triangle %d MinAngle %v < WorstTriangle %d MinAngle %v", i,
+				other.MinAngle, worst, q.MinAngle)
+		}
+	}
+}
+
+func TestTriangleQuality_InvalidInput(t *testing.T) {
+	dt := mustNewTriangulation(t, 10)
+	if _, err := dt.TriangleQuality(-1, MinAngle); err == nil {
+		t.Errorf("dt.TriangleQuality(-1, MinAngle) error = nil, want non-nil")
+	}
+	if _, err := dt.TriangleQuality(len(dt.Triangles), MinAngle); err == nil {
+		t.Errorf("dt.TriangleQuality(%d, MinAngle) error = nil, want non-nil", len(dt.Triangles))
+	}
+	if _, err := dt.TriangleQuality(0, QualityMetric(-1)); err == nil {
+		t.Errorf("dt.TriangleQuality(0, QualityMetric(-1)) error = nil, want non-nil")
+	}
+}
+
+func TestWorstTriangles_Sliver(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(100, 0)
+	dup := len(vertices)
+	near := s2.Point{Vector: vertices[0].Add(s2.Ortho(vertices[0]).Mul(1e-6)).Normalize()}
+	vertices = append(vertices, near)
+	dt, err := NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+
+	for _, metric := range []QualityMetric{MinAngle, RadiusEdgeRatio, AspectRatio} {
+		worst := dt.WorstTriangles(1, metric)
+		if len(worst) != 1 {
+			t.Fatalf("dt.WorstTriangles(1, %v) len = %d, want 1", metric, len(worst))
+		}
+		tri := dt.Triangles[worst[0]]
+		if !slices.Contains(tri[:], 0) || !slices.Contains(tri[:], dup) {
+			t.Errorf("dt.WorstTriangles(1, %v) = %v, want triangle with vertices 0 and %d",
+				metric, tri, dup)
+		}
+	}
+}
+
+func TestWorstTriangles_Ordering(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	for _, metric := range []QualityMetric{MinAngle, RadiusEdgeRatio, AspectRatio} {
+		got := dt.WorstTriangles(len(dt.Triangles)+10, metric)
+		if len(got) != len(dt.Triangles) {
+			t.Fatalf("dt.WorstTriangles(..., %v) len = %d, want %d", metric, len(got),
+				len(dt.Triangles))
+		}
+		for i := 1; i < len(got); i++ {
+			prev, _ := dt.TriangleQuality(got[i-1], metric)
+			cur, _ := dt.TriangleQuality(got[i], metric)
+			if metric == MinAngle {
+				prev, cur = -prev, -cur
+			}
+			if prev < cur || (prev == cur && got[i-1] > got[i]) {
+				t.Errorf("dt.WorstTriangles(..., %v) not ordered at %d", metric, i)
+			}
+		}
+
+		again := dt.WorstTriangles(len(dt.Triangles), metric)
+		if diff := cmp.Diff(got, again); diff != "" {
+			t.Errorf("dt.WorstTriangles(..., %v) not deterministic (-first +second):\n%s", metric,
+				diff)
+		}
+	}
+
+	if got := dt.WorstTriangles(0, MinAngle); got != nil {
+		t.Errorf("dt.WorstTriangles(0, MinAngle) = %v, want nil", got)
+	}
+}
+
+func TestLocalFeatureSize(t *testing.T) {
+	dt := mustNewTriangulation(t, 200)
+	got := dt.LocalFeatureSize()
+	if len(got) != len(dt.Vertices) {
+		t.Fatalf("len(dt.LocalFeatureSize()) = %d, want %d", len(got), len(dt.Vertices))
+	}
+	for v := range dt.Vertices {
+		incident, err := dt.IncidentTriangles(v)
+		if err != nil {
+			t.Fatalf("dt.IncidentTriangles(%d) error = %v, want nil", v, err)
+		}
+		want := math.Inf(1)
+		for _, tIdx := range incident {
+			n, err := NextVertex(dt.Triangles[tIdx], v)
+			if err != nil {
+				t.Fatalf("NextVertex(...) error = %v, want nil", err)
+			}
+			want = min(want, dt.Vertices[v].Distance(dt.Vertices[n]).Radians())
+		}
+		if got[v] != want {
+			t.Errorf("dt.LocalFeatureSize()[%d] = %v, want %v", v, got[v], want)
+		}
+	}
+
+	tetra := mustNewTetrahedron(t)
+	edge := tetra.Vertices[0].Distance(tetra.Vertices[1]).Radians()
+	for v, l := range tetra.LocalFeatureSize() {
+		if math.Abs(l-edge) > 1e-12 {
+			t.Errorf("tetra.LocalFeatureSize()[%d] = %v, want %v", v, l, edge)
+		}
+	}
+}
+
+// Helpers
+
+func mustNewTetrahedron(t *testing.T) *Triangulation {
+	t.Helper()
+	vertices := s2.PointVector{
+		s2.PointFromCoords(1, 1, 1),
+		s2.PointFromCoords(1, -1, -1),
+		s2.PointFromCoords(-1, 1, -1),
+		s2.PointFromCoords(-1, -1, 1),
+	}
+	dt, err := NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	return dt
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// RemovePoint deletes the vertex at the given index and retriangulates the star-shaped hole
+// left by its incident triangles with Delaunay ears, each of them CCW with no other vertex of
+// the hole inside its circumcap, then restores the Delaunay property by edge flips around the
+// hole. Vertices are compacted, so every vertex after vIdx moves down by one index, and
+// SourceIndex is updated to match. The triangle and incidence arrays are rebuilt in place, so
+// triangle indices change.
+// It returns an error if the triangulation is partial, vIdx is out of range, only 4 vertices
+// remain, or the hole cannot be triangulated.
+func (t *Triangulation) RemovePoint(vIdx int) error {
+	if t.Partial {
+		return fmt.Errorf("RemovePoint: %w: triangulation is partial", ErrInvalidMesh)
+	}
+	if vIdx < 0 || vIdx >= len(t.Vertices) {
+		return fmt.Errorf("RemovePoint: vIdx %d %w [0 %d)", vIdx, ErrOutOfRange, len(t.Vertices))
+	}
+	if len(t.Vertices) <= 4 {
+		return fmt.Errorf("RemovePoint: %w", ErrInsufficientVertices)
+	}
+
+	// Each incident triangle (vIdx, a, b) contributes the hole edge from a to b, which keeps
+	// the hole on its left.
+	incident, _ := t.IncidentTriangles(vIdx)
+	next := make(map[int]int, len(incident))
+	for _, tIdx := range incident {
+		tri := t.Triangles[tIdx]
+		a := tri.NextVertex(vIdx)
+		next[a] = tri.NextVertex(a)
+	}
+	hole := make([]int, 0, len(incident))
+	v := t.Triangles[incident[0]].NextVertex(vIdx)
+	for range incident {
+		hole = append(hole, v)
+		v = next[v]
+	}
+	filled, err := t.fillHole(hole)
+	if err != nil {
+		return fmt.Errorf("RemovePoint: %w", err)
+	}
+
+	tris := make([]Triangle, 0, len(t.Triangles)-2)
+	for tIdx, tri := range t.Triangles {
+		if !slices.Contains(incident, tIdx) {
+			tris = append(tris, tri)
+		}
+	}
+	tris = append(tris, filled...)
+	m := newFlipMesh(tris)
+	var stack [][2]int
+	for _, tri := range filled {
+		for j := range 3 {
+			stack = append(stack, [2]int{tri[j], tri[(j+1)%3]})
+		}
+	}
+	if _, _, err := m.lawson(t.Vertices, stack, -1); err != nil {
+		return fmt.Errorf("RemovePoint: %w", err)
+	}
+
+	for i, tri := range m.tris {
+		for j, v := range tri {
+			if v > vIdx {
+				m.tris[i][j] = v - 1
+			}
+		}
+	}
+	// The vertex slice may be shared with the caller, so it is never modified in place.
+	old := t.Vertices
+	t.Vertices = slices.Delete(slices.Clone(t.Vertices), vIdx, vIdx+1)
+	if err := t.setTriangles(m.tris); err != nil {
+		t.Vertices = old
+		return fmt.Errorf("RemovePoint: %w", err)
+	}
+	for i, v := range t.SourceIndex {
+		switch {
+		case v == vIdx:
+			t.SourceIndex[i] = -1
+		case v > vIdx:
+			t.SourceIndex[i] = v - 1
+		}
+	}
+	return nil
+}
+
+// fillHole triangulates the CCW polygon of vertex indices by repeatedly cutting off an ear
+// (a, b, c) that is CCW and has no other polygon vertex strictly inside its circumcap.
+// It returns an error if no such ear exists.
+func (t *Triangulation) fillHole(hole []int) ([]Triangle, error) {
+	hole = slices.Clone(hole)
+	var out []Triangle
+	for len(hole) > 3 {
+		ear := -1
+		n := len(hole)
+		for i := range n {
+			a, b, c := hole[(i+n-1)%n], hole[i], hole[(i+1)%n]
+			pa, pb, pc := t.Vertices[a], t.Vertices[b], t.Vertices[c]
+			if s2.RobustSign(pa, pb, pc) != s2.CounterClockwise {
+				continue
+			}
+			empty := true
+			for _, d := range hole {
+				if d != a && d != b && d != c && InCircumcap(pa, pb, pc, t.Vertices[d]) {
+					empty = false
+					break
+				}
+			}
+			if empty {
+				ear = i
+				break
+			}
+		}
+		if ear < 0 {
+			return nil, fmt.Errorf("%w: no Delaunay ear in hole %v", ErrInvalidMesh, hole)
+		}
+		out = append(out, Triangle{hole[(ear+n-1)%n], hole[ear], hole[(ear+1)%n]})
+		hole = slices.Delete(hole, ear, ear+1)
+	}
+	return append(out, Triangle{hole[0], hole[1], hole[2]}), nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+)
+
+// Remove
+
+func TestRemovePoint(t *testing.T) {
+	points := utils.GenerateRandomPoints(200, 0)
+	dt, err := NewTriangulation(points)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	for i := range 100 {
+		if err := dt.RemovePoint(i % len(dt.Vertices)); err != nil {
+			t.Fatalf("removal %d: dt.RemovePoint(...) error = %v, want nil", i, err)
+		}
+		if err := dt.checkStructure(); err != nil {
+			t.Fatalf("removal %d: dt.checkStructure() error = %v, want nil", i, err)
+		}
+		if got, want := len(dt.Triangles), 2*len(dt.Vertices)-4; got != want {
+			t.Fatalf("removal %d: %d triangles, want %d", i, got, want)
+		}
+	}
+	if _, err := dt.MakeDelaunay(0); err != nil {
+		t.Fatalf("triangulation is not Delaunay after removals: %v", err)
+	}
+	if len(points) != 200 {
+		t.Errorf("len(points) = %d after removals, want 200", len(points))
+	}
+
+	want, err := NewTriangulation(dt.Vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	if !slices.Equal(sortedTriangles(dt.Triangles), sortedTriangles(want.Triangles)) {
+		t.Errorf("triangles after removals differ from NewTriangulation of the remaining points")
+	}
+}
+
+func TestRemovePoint_RoundTrip(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	orig := slices.Clone(dt.Triangles)
+	const k = 17
+	p := dt.Vertices[k]
+	if err := dt.RemovePoint(k); err != nil {
+		t.Fatalf("dt.RemovePoint(%d) error = %v, want nil", k, err)
+	}
+	n, err := dt.InsertPoint(p)
+	if err != nil || n != 99 {
+		t.Fatalf("dt.InsertPoint(...) = %d, %v, want 99, nil", n, err)
+	}
+
+	// Vertex k moved to the end and the vertices after it moved down by one.
+	remap := func(v int) int {
+		switch {
+		case v == k:
+			return 99
+		case v > k:
+			return v - 1
+		}
+		return v
+	}
+	want := make([]Triangle, len(orig))
+	for i, tri := range orig {
+		want[i] = Triangle{remap(tri[0]), remap(tri[1]), remap(tri[2])}
+	}
+	if !slices.Equal(sortedTriangles(dt.Triangles), sortedTriangles(want)) {
+		t.Errorf("triangles after RemovePoint and InsertPoint differ from the original")
+	}
+}
+
+func TestRemovePoint_Invalid(t *testing.T) {
+	dt := mustNewTetrahedron(t)
+	if err := dt.RemovePoint(0); !errors.Is(err, ErrInsufficientVertices) {
+		t.Errorf("tetrahedron.RemovePoint(0) error = %v, want %v", err, ErrInsufficientVertices)
+	}
+	dt = mustNewTriangulation(t, 10)
+	for _, v := range []int{-1, 10} {
+		if err := dt.RemovePoint(v); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.RemovePoint(%d) error = %v, want %v", v, err, ErrOutOfRange)
+		}
+	}
+	if err := (&Triangulation{Partial: true}).RemovePoint(0); !errors.Is(err, ErrInvalidMesh) {
+		t.Errorf("partial.RemovePoint(0) error = %v, want %v", err, ErrInvalidMesh)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+// ResampleVertices transfers per-vertex values from src to the vertices of dst by barycentric
+// interpolation over the src triangle containing each dst vertex. Consecutive dst vertices
+// seed the point location walk, so spatially coherent vertex orders resample fastest.
+// Values are reproduced exactly at dst vertices that coincide with src vertices.
+// It returns an error if srcValues does not have one value per src vertex.
+func ResampleVertices(src *Triangulation, srcValues []float64, dst *Triangulation) ([]float64,
+	error) {
+	if len(srcValues) != len(src.Vertices) {
+		return nil, fmt.Errorf("ResampleVertices: got %d values for %d vertices", len(srcValues),
+			len(src.Vertices))
+	}
+
+	values := make([]float64, len(dst.Vertices))
+	tIdx := 0
+	for i, p := range dst.Vertices {
+		tIdx = src.locate(p, tIdx, nil)
+		tri := src.Triangles[tIdx]
+		w := barycentricWeights(p, src.Vertices[tri[0]], src.Vertices[tri[1]], src.Vertices[tri[2]])
+		values[i] = w[0]*srcValues[tri[0]] + w[1]*srcValues[tri[1]] + w[2]*srcValues[tri[2]]
+	}
+	return values, nil
+}
+
+// barycentricWeights returns the barycentric coordinates of the central projection of p onto
+// the plane of the triangle abc. Points equal to a vertex get exact unit weights.
+func barycentricWeights(p, a, b, c s2.Point) [3]float64 {
+	switch p {
+	case a:
+		return [3]float64{1, 0, 0}
+	case b:
+		return [3]float64{0, 1, 0}
+	case c:
+		return [3]float64{0, 0, 1}
+	}
+	wa := p.Dot(b.Cross(c.Vector))
+	wb := p.Dot(c.Cross(a.Vector))
+	wc := p.Dot(a.Cross(b.Vector))
+	sum := wa + wb + wc
+	return [3]float64{wa / sum, wb / sum, wc / sum}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Resample
+
+func TestResampleVertices_Identity(t *testing.T) {
+	src := mustNewTriangulation(t, 100)
+	values := make([]float64, len(src.Vertices))
+	for i := range values {
+		values[i] = float64(i*i) - 3.5
+	}
+
+	got, err := ResampleVertices(src, values, src)
+	if err != nil {
+		t.Fatalf("ResampleVertices(src, values, src) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(values, got); diff != "" {
+		t.Errorf("ResampleVertices(src, values, src) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestResampleVertices_SmoothField(t *testing.T) {
+	src := mustNewTriangulation(t, 10000)
+	dst, err := NewTriangulation(utils.GenerateRandomPoints(1000, 1))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	field := func(p s2.Point) float64 { return p.Z + 0.5*p.X*p.Y }
+	values := make([]float64, len(src.Vertices))
+	for i, p := range src.Vertices {
+		values[i] = field(p)
+	}
+
+	got, err := ResampleVertices(src, values, dst)
+	if err != nil {
+		t.Fatalf("ResampleVertices(...) error = %v, want nil", err)
+	}
+	gotMean, wantMean := 0.0, 0.0
+	for i, p := range dst.Vertices {
+		want := field(p)
+		if math.Abs(got[i]-want) > 1e-2 {
+			t.Errorf("resampled[%d] = %v, want ~%v", i, got[i], want)
+		}
+		gotMean += got[i]
+		wantMean += want
+	}
+	gotMean /= float64(len(got))
+	wantMean /= float64(len(got))
+	if math.Abs(gotMean-wantMean) > 1e-3 {
+		t.Errorf("resampled mean = %v, want ~%v", gotMean, wantMean)
+	}
+}
+
+func TestResampleVertices_InvalidInput(t *testing.T) {
+	src := mustNewTriangulation(t, 10)
+	if _, err := ResampleVertices(src, make([]float64, 9), src); err == nil {
+		t.Errorf("ResampleVertices(...) error = nil, want non-nil")
+	}
+}
@@ -7,35 +7,119 @@
 package s2delaunay
 
 import (
-	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"sync"
 
+	"github.com/2dChan/s2voronoi/internal/buildclock"
 	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
-	"github.com/markus-wa/quickhull-go/v2"
 )
 
 const (
-	defaultEps = 1e-12
+	defaultEps     = 1e-12
+	defaultIDLevel = s2.MaxLevel
 )
 
+// Triangle is a triangle of a triangulation given by the indices of its three vertices.
+type Triangle [3]int
+
+// PrevVertex returns the vertex before vIdx in the triangle, or -1 if vIdx is not one of its
+// vertices.
+func (t Triangle) PrevVertex(vIdx int) int {
+	switch vIdx {
+	case t[0]:
+		return t[2]
+	case t[1]:
+		return t[0]
+	case t[2]:
+		return t[1]
+	}
+	return -1
+}
+
+// NextVertex returns the vertex after vIdx in the triangle, or -1 if vIdx is not one of its
+// vertices.
+func (t Triangle) NextVertex(vIdx int) int {
+	switch vIdx {
+	case t[0]:
+		return t[1]
+	case t[1]:
+		return t[2]
+	case t[2]:
+		return t[0]
+	}
+	return -1
+}
+
 // Triangulation represents a Delaunay triangulation on the S2 sphere.
 type Triangulation struct {
-	// Vertices are the input points on the unit sphere.
+	// Vertices are the input points on the unit sphere. They are a copy of the input owned by
+	// the triangulation unless it was created under WithBorrowInput.
 	Vertices s2.PointVector
 	// Triangles are the triangulation triangles, each with three vertex indices,
 	// sorted CCW when looking out of the sphere.
-	Triangles [][3]int
+	Triangles []Triangle
 	// IncidentTriangleIndices contains indices of incident triangles for each vertex,
 	// sorted CCW when looking out of the sphere, forming a CSR-like sparse representation.
 	IncidentTriangleIndices []int
 	// IncidentTriangleOffsets contains offsets for slicing incident triangle data in a CSR-like format.
 	IncidentTriangleOffsets []int
+	// Partial reports that the triangulation was built from an incomplete convex hull under
+	// WithPartialResults. A partial triangulation does not satisfy the invariants above: vertices
+	// may have no incident triangles and incident rings may not be closed.
+	Partial bool
+	// SourceIndex maps each input vertex to the index of the vertex it was merged into under
+	// WithDeduplication, and is nil otherwise. RemovePoint maps the vertices it removes to -1.
+	SourceIndex []int
+
+	adjacencyOnce sync.Once
+	adjacency     [][3]int
+
+	edgesOnce sync.Once
+	edges     [][2]int
+
+	idLevel     int
+	idsOnce     sync.Once
+	triangleIDs map[uint64]int
+	idsErr      error
+
+	// verticesHash is the checksum of Vertices when the triangulation was built, valid if
+	// hashed is set.
+	verticesHash uint64
+	hashed       bool
 }
 
 // TriangulationOptions holds configuration options for Delaunay triangulation.
 type TriangulationOptions struct {
 	Eps float64
+	// AutoEps derives Eps from the input vertex spacing, overriding the Eps field.
+	AutoEps bool
+	// IDLevel is the S2 cell level at which vertices are snapped when deriving triangle IDs.
+	IDLevel int
+	// PartialResults builds a partial triangulation instead of failing when QuickHull returns an
+	// incomplete hull.
+	PartialResults bool
+	// FixOrientation makes FromMesh reorient triangles consistently outward instead of failing
+	// on clockwise triangles.
+	FixOrientation bool
+	// Diagnostics, if set, receives the outcome of the QuickHull run.
+	Diagnostics *HullDiagnostics
+	// HullSeed, if set, holds the six extreme vertices QuickHull starts from.
+	HullSeed []int
+	// Metrics, if set, receives the build metrics.
+	Metrics *BuildMetrics
+	// BorrowInput makes the triangulation store the input vertices instead of a copy.
+	BorrowInput bool
+	// Deduplicate merges input vertices within DeduplicationTolerance of each other.
+	Deduplicate bool
+	// DeduplicationTolerance is the angle within which Deduplicate merges vertices.
+	DeduplicationTolerance s1.Angle
+	// Normalize projects the input vertices onto the unit sphere instead of rejecting vertices
+	// whose norm is not 1.
+	Normalize bool
 }
 
 // TriangulationOption is a functional option type for triangulation configuration.
@@ -46,20 +130,96 @@ type TriangulationOption func(*TriangulationOptions) error
 func WithEps(eps float64) TriangulationOption {
 	return func(o *TriangulationOptions) error {
 		if eps <= 0 {
-			return fmt.Errorf("WithEps: eps must be positive got %v", eps)
+			return &OptionError{Name: "WithEps",
+				Err: fmt.Errorf("%w: eps must be positive got %v", ErrInvalidOption, eps)}
 		}
 		o.Eps = eps
+		o.AutoEps = false
+		return nil
+	}
+}
+
+// WithAutoEps derives the numerical precision epsilon from the input vertex spacing instead of
+// using a fixed value. See AutoEps for the heuristic. A later WithEps overrides it.
+func WithAutoEps() TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		o.AutoEps = true
+		return nil
+	}
+}
+
+// WithPartialResults makes NewTriangulation return a best-effort triangulation with Partial set
+// when QuickHull returns an inconsistent hull, such as for near-degenerate input, instead of
+// an error. Degenerate and duplicate triangles of the hull are dropped. A partial
+// triangulation is meant for visualization and may fail validation.
+func WithPartialResults() TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		o.PartialResults = true
+		return nil
+	}
+}
+
+// WithFixOrientation makes FromMesh flip triangles so that all of them are CCW when looking out
+// of the sphere, as long as the mesh is orientable. NewTriangulation ignores it.
+func WithFixOrientation() TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		o.FixOrientation = true
+		return nil
+	}
+}
+
+// WithIDLevel sets the S2 cell level used to derive stable triangle IDs. Vertices that move
+// within their cell at this level keep the IDs of their triangles.
+// It must be in [0, s2.MaxLevel].
+func WithIDLevel(level int) TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		if level < 0 || level > s2.MaxLevel {
+			return &OptionError{Name: "WithIDLevel",
+				Err: fmt.Errorf("%w: level must be in [0 %d] got %d",
+					ErrInvalidOption, s2.MaxLevel, level)}
+		}
+		o.IDLevel = level
+		return nil
+	}
+}
+
+// WithBorrowInput makes the triangulation store the input vertices as Vertices instead of a
+// copy, saving an allocation. The caller must then not modify the input afterwards, since the
+// triangulation and everything derived from it would silently become inconsistent.
+func WithBorrowInput() TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		o.BorrowInput = true
+		return nil
+	}
+}
+
+// WithNormalize makes NewTriangulation project every input vertex onto the unit sphere before
+// computing the hull, for input that is only approximately unit length. The triangulation then
+// stores the projected vertices, in a copy of the input even under WithBorrowInput.
+func WithNormalize() TriangulationOption {
+	return func(o *TriangulationOptions) error {
+		o.Normalize = true
 		return nil
 	}
 }
 
 // NewTriangulation creates a Delaunay triangulation from the given vertices.
-// The vertices must lie on the unit sphere, there must be at least 4 vertices, and they must not be coplanar.
-// It returns an error if the triangulation cannot be constructed.
+// The vertices must lie on the unit sphere, within 1e-9 of unit norm whatever the Eps, unless
+// WithNormalize is given. There must be at least 4 vertices, and they must not be coplanar.
+// The triangulation stores a copy of the vertices, so the input may be modified afterwards,
+// unless WithBorrowInput is given.
+// Four or more vertices on a common circle, within Eps, lie on one planar hull face, and every
+// triangulation of that face is Delaunay. The tie is resolved by the split QuickHull makes,
+// which is deterministic for the same vertices in the same order and, under WithHullSeed,
+// independent of their order.
+// It returns an error if the triangulation cannot be constructed, which is a *VertexError if a
+// vertex has a non-finite component, is the zero vector or is not unit length, and a
+// *DuplicateError if input vertices coincide and WithDeduplication is not given.
 func NewTriangulation(vertices s2.PointVector, setters ...TriangulationOption) (*Triangulation,
 	error) {
 	opts := TriangulationOptions{
-		Eps: defaultEps,
+		Eps:     defaultEps,
+		IDLevel: defaultIDLevel,
 	}
 	for _, set := range setters {
 		err := set(&opts)
@@ -67,29 +227,186 @@ func NewTriangulation(vertices s2.PointVector, setters ...TriangulationOption) (
 			return nil, err
 		}
 	}
+	normTol := unitNormTolerance
+	if opts.Normalize {
+		normTol = math.Inf(1)
+	}
+	if err := checkVertices(vertices, normTol); err != nil {
+		return nil, fmt.Errorf("NewTriangulation: %w", err)
+	}
+	if opts.Normalize {
+		vertices = normalizeVertices(vertices)
+	}
+	var source []int
+	if opts.Deduplicate {
+		vertices, source = mergeVertices(vertices, opts.DeduplicationTolerance)
+	} else if !opts.BorrowInput && !opts.Normalize {
+		vertices = slices.Clone(vertices)
+	}
 	numVertices := len(vertices)
-	if numVertices < 4 {
-		return nil,
-			errors.New("NewTriangulation: insufficient vertices for triangulation minimum 4 required")
+	var clock *buildclock.Clock
+	if m := opts.Metrics; m != nil {
+		clock = buildclock.Start()
+		*m = BuildMetrics{Vertices: numVertices}
+		defer func() { m.Total, m.Allocs, m.AllocBytes = clock.Finish() }()
 	}
-	numTriangles := 2 * (numVertices - 2)
-	t := &Triangulation{
-		Vertices:                vertices,
-		Triangles:               make([][3]int, numTriangles),
-		IncidentTriangleIndices: make([]int, numTriangles*3),
-		IncidentTriangleOffsets: make([]int, numVertices+1),
+	if numVertices < 4 {
+		return nil, fmt.Errorf("NewTriangulation: %w", ErrInsufficientVertices)
 	}
 	r3vertices := make([]r3.Vector, numVertices)
 	for i, p := range vertices {
 		r3vertices[i] = p.Vector
 	}
-	qh := new(quickhull.QuickHull)
-	ch := qh.ConvexHull(r3vertices, true, true, opts.Eps)
-	if len(ch.Indices) != numTriangles*3 {
-		return nil,
-			errors.New("NewTriangulation: inconsistent number of indices returned from QuickHull")
+	if opts.AutoEps {
+		opts.Eps = AutoEps(vertices)
+	}
+	if clock != nil {
+		clock.Lap()
+	}
+	indices, err := convexHull(r3vertices, opts)
+	if clock != nil {
+		opts.Metrics.Hull = clock.Lap()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("NewTriangulation: %w", err)
+	}
+	var t *Triangulation
+	switch {
+	case len(indices) == 2*(numVertices-2)*3:
+		t, err = buildTriangulation(vertices, indices, opts.IDLevel, opts.Metrics, clock)
+	case opts.PartialResults:
+		t, err = newPartialTriangulation(vertices, indices, opts.IDLevel, opts.Metrics, clock)
+	default:
+		// QuickHull merges coincident vertices, which is the usual cause of a short hull.
+		if pairs := duplicatePairs(vertices, duplicateTolerance); len(pairs) > 0 {
+			return nil, fmt.Errorf("NewTriangulation: %w", &DuplicateError{Pairs: pairs})
+		}
+		return nil, fmt.Errorf(
+			"NewTriangulation: %w: inconsistent number of indices returned from QuickHull",
+			ErrInvalidHull)
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.SourceIndex = source
+	return t, nil
+}
+
+// checkVertices returns a *VertexError for the first vertex with a NaN or infinite component,
+// equal to the zero vector, or whose norm differs from 1 by more than normTol, which QuickHull
+// would otherwise turn into a corrupt hull.
+func checkVertices(vertices s2.PointVector, normTol float64) error {
+	for i, p := range vertices {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsNaN(p.Z) ||
+			math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) || math.IsInf(p.Z, 0) ||
+			(p.X == 0 && p.Y == 0 && p.Z == 0) || math.Abs(p.Norm()-1) > normTol {
+			return &VertexError{Index: i, Vertex: p}
+		}
+	}
+	return nil
+}
+
+// normalizeVertices returns a copy of the vertices projected onto the unit sphere.
+func normalizeVertices(vertices s2.PointVector) s2.PointVector {
+	normalized := make(s2.PointVector, len(vertices))
+	for i, p := range vertices {
+		normalized[i] = s2.Point{Vector: p.Normalize()}
 	}
-	for _, idx := range ch.Indices {
+	return normalized
+}
+
+// NewTriangulationFromHullIndices creates a Delaunay triangulation from the given vertices and a
+// precomputed convex hull, skipping QuickHull. The hull is given as a flat array of triangle
+// vertex indices, three per triangle, as returned by QuickHull; triangle orientation need not be
+// consistent. Triangles whose vertices span a parallelogram of area at most eps are rejected as
+// degenerate. Triangle IDs use the default level. The triangulation stores a copy of the
+// vertices and does not retain hullIndices.
+// It returns an error if there are fewer than 4 vertices, eps is not positive, the index count
+// is not 2(n-2)·3, or an index is out of range.
+func NewTriangulationFromHullIndices(vertices s2.PointVector, hullIndices []int,
+	eps float64) (*Triangulation, error) {
+	numVertices := len(vertices)
+	if numVertices < 4 {
+		return nil, fmt.Errorf("NewTriangulationFromHullIndices: %w", ErrInsufficientVertices)
+	}
+	if eps <= 0 {
+		return nil, fmt.Errorf("NewTriangulationFromHullIndices: %w: eps must be positive got %v",
+			ErrInvalidOption, eps)
+	}
+	if want := 2 * (numVertices - 2) * 3; len(hullIndices) != want {
+		return nil, fmt.Errorf("NewTriangulationFromHullIndices: %w: got %d indices, want %d",
+			ErrInvalidHull, len(hullIndices), want)
+	}
+	for i := 0; i < len(hullIndices); i += 3 {
+		tri := hullIndices[i : i+3]
+		for _, v := range tri {
+			if v < 0 || v >= numVertices {
+				return nil, fmt.Errorf(
+					"NewTriangulationFromHullIndices: index %d %w [0 %d)", v, ErrOutOfRange,
+					numVertices)
+			}
+		}
+		p0, p1, p2 := vertices[tri[0]], vertices[tri[1]], vertices[tri[2]]
+		if p1.Sub(p0.Vector).Cross(p2.Sub(p0.Vector)).Norm() <= eps {
+			return nil, fmt.Errorf("NewTriangulationFromHullIndices: %w: triangle %d is degenerate",
+				ErrInvalidHull, i/3)
+		}
+	}
+	return newTriangulation(slices.Clone(vertices), hullIndices, defaultIDLevel)
+}
+
+// newPartialTriangulation builds a partial triangulation from the triangles of an inconsistent
+// hull, dropping triangles with repeated vertices and triangles listed more than once.
+// The build phases are measured into m with clock if m is not nil.
+func newPartialTriangulation(vertices s2.PointVector, indices []int, idLevel int,
+	m *BuildMetrics, clock *buildclock.Clock) (*Triangulation, error) {
+	var kept []int
+	seen := make(map[[3]int]bool)
+	for i := 0; i+3 <= len(indices); i += 3 {
+		tri := [3]int{indices[i], indices[i+1], indices[i+2]}
+		if tri[0] == tri[1] || tri[1] == tri[2] || tri[2] == tri[0] {
+			continue
+		}
+		key := tri
+		slices.Sort(key[:])
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, tri[:]...)
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("NewTriangulation: %w: no usable triangles returned from QuickHull",
+			ErrInvalidHull)
+	}
+	t, err := buildTriangulation(vertices, kept, idLevel, m, clock)
+	if err != nil {
+		return nil, err
+	}
+	t.Partial = true
+	return t, nil
+}
+
+// newTriangulation builds the triangles and the incidence arrays from flat hull indices.
+func newTriangulation(vertices s2.PointVector, indices []int, idLevel int) (*Triangulation,
+	error) {
+	return buildTriangulation(vertices, indices, idLevel, nil, nil)
+}
+
+// buildTriangulation is newTriangulation measuring the build phases into m with clock if m is
+// not nil.
+func buildTriangulation(vertices s2.PointVector, indices []int, idLevel int, m *BuildMetrics,
+	clock *buildclock.Clock) (*Triangulation, error) {
+	numVertices := len(vertices)
+	numTriangles := len(indices) / 3
+	t := &Triangulation{
+		Vertices:                vertices,
+		Triangles:               make([]Triangle, numTriangles),
+		IncidentTriangleIndices: make([]int, numTriangles*3),
+		IncidentTriangleOffsets: make([]int, numVertices+1),
+		idLevel:                 idLevel,
+	}
+	for _, idx := range indices {
 		t.IncidentTriangleOffsets[idx+1]++
 	}
 	for i := range numVertices {
@@ -100,13 +417,22 @@ func NewTriangulation(vertices s2.PointVector, setters ...TriangulationOption) (
 	for i := range numTriangles {
 		base := i * 3
 		for j := range 3 {
-			v := ch.Indices[base+j]
+			v := indices[base+j]
 			t.Triangles[i][j] = v
 			t.IncidentTriangleIndices[nxt[v]] = i
 			nxt[v]++
 		}
+	}
+	if m != nil {
+		m.Triangles = numTriangles
+		m.IncidenceBuild = clock.Lap()
+	}
+	for i := range numTriangles {
 		sortTriangleVerticesCCW(&t.Triangles[i], t.Vertices)
 	}
+	if m != nil {
+		m.Orientation = clock.Lap()
+	}
 	for i := range numVertices {
 		incidentTriangles, err := t.IncidentTriangles(i)
 		if err != nil {
@@ -114,6 +440,10 @@ func NewTriangulation(vertices s2.PointVector, setters ...TriangulationOption) (
 		}
 		sortIncidentTriangleIndicesCCW(i, incidentTriangles, t.Triangles)
 	}
+	if m != nil {
+		m.IncidenceSort = clock.Lap()
+	}
+	t.recordVertices()
 	return t, nil
 }
 
@@ -123,7 +453,7 @@ func NewTriangulation(vertices s2.PointVector, setters ...TriangulationOption) (
 func (t *Triangulation) IncidentTriangles(vIdx int) ([]int, error) {
 	if vIdx < 0 || vIdx+1 >= len(t.IncidentTriangleOffsets) {
 		return nil,
-			fmt.Errorf("IncidentTriangles: vIdx %d out of range [0 %d)", vIdx,
+			fmt.Errorf("IncidentTriangles: vIdx %d %w [0 %d)", vIdx, ErrOutOfRange,
 				len(t.IncidentTriangleOffsets)-1)
 	}
 	start := t.IncidentTriangleOffsets[vIdx]
@@ -131,40 +461,139 @@ func (t *Triangulation) IncidentTriangles(vIdx int) ([]int, error) {
 	return t.IncidentTriangleIndices[start:end], nil
 }
 
+// IncidentVertices returns the indices of the vertices sharing an edge with the vertex at the
+// given index, in the CCW order of IncidentTriangles: entry k is the NextVertex of the vertex
+// in incident triangle k, and entries k-1 and k, cyclically, are the other two vertices of
+// that triangle.
+// It returns an error if the vertex index is out of range, or wrapping ErrInvalidMesh if an
+// incident triangle does not contain the vertex.
+func (t *Triangulation) IncidentVertices(vIdx int) ([]int, error) {
+	if vIdx < 0 || vIdx+1 >= len(t.IncidentTriangleOffsets) {
+		return nil,
+			fmt.Errorf("IncidentVertices: vIdx %d %w [0 %d)", vIdx, ErrOutOfRange,
+				len(t.IncidentTriangleOffsets)-1)
+	}
+	ring, err := t.vertexNeighbors(vIdx)
+	if err != nil {
+		return nil, fmt.Errorf("IncidentVertices: %w", err)
+	}
+	return ring, nil
+}
+
 // TriangleVertices returns the three vertices of the triangle at the given index.
 // It returns an error if the triangle index is out of bounds.
 func (t *Triangulation) TriangleVertices(tIdx int) ([3]s2.Point, error) {
 	if tIdx < 0 || tIdx >= len(t.Triangles) {
 		return [3]s2.Point{},
-			fmt.Errorf("TriangleVertices: tIdx %d out of bounds [0 %d)", tIdx, len(t.Triangles))
+			fmt.Errorf("TriangleVertices: tIdx %d %w [0 %d)", tIdx, ErrOutOfRange,
+				len(t.Triangles))
 	}
 	tri := t.Triangles[tIdx]
 	return [3]s2.Point{t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]]}, nil
 }
 
-// sortTriangleVerticesCCW sorts triangle vertices in CCW order.
-func sortTriangleVerticesCCW(t *[3]int, v s2.PointVector) {
-	p0, p1, p2 := v[t[0]], v[t[1]], v[t[2]]
-	norm := p1.Sub(p0.Vector).Cross(p2.Sub(p0.Vector))
-	if norm.Dot(p0.Vector) < 0 {
+// AdjacentTriangles returns the three triangles sharing an edge with the triangle at the given
+// index, where entry j is the triangle across the edge opposite vertex Triangles[tIdx][j]. It is
+// the triangle counterpart of IncidentTriangles. The adjacency of all triangles is built on the
+// first call and reused afterwards.
+// It returns an error if the triangle index is out of bounds.
+func (t *Triangulation) AdjacentTriangles(tIdx int) ([3]int, error) {
+	if tIdx < 0 || tIdx >= len(t.Triangles) {
+		return [3]int{}, fmt.Errorf("AdjacentTriangles: tIdx %d %w [0 %d)", tIdx, ErrOutOfRange,
+			len(t.Triangles))
+	}
+	return t.triangleAdjacency()[tIdx], nil
+}
+
+// FaceNormals returns the outward unit normal of each triangle, indexed like Triangles.
+func (t *Triangulation) FaceNormals() []r3.Vector {
+	normals := make([]r3.Vector, len(t.Triangles))
+	for i, tri := range t.Triangles {
+		p0, p1, p2 := t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]]
+		normals[i] = p1.Sub(p0.Vector).Cross(p2.Sub(p0.Vector)).Normalize()
+	}
+	return normals
+}
+
+// TriangleArea returns the spherical area of the triangle at the given index in steradians,
+// computed with SphericalTriangleArea, which uses l'Huilier's theorem and stays non-negative
+// and finite for near-degenerate triangles.
+// It returns an error if the triangle index is out of bounds.
+func (t *Triangulation) TriangleArea(tIdx int) (float64, error) {
+	if tIdx < 0 || tIdx >= len(t.Triangles) {
+		return 0, fmt.Errorf("TriangleArea: tIdx %d %w [0 %d)", tIdx, ErrOutOfRange,
+			len(t.Triangles))
+	}
+	tri := t.Triangles[tIdx]
+	return SphericalTriangleArea(t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]]), nil
+}
+
+// Circumcenter returns the center of the circumcircle of the triangle at the given index, on
+// the side of the triangle as computed by the package-level Circumcenter. It is the Voronoi
+// vertex dual to the triangle.
+// It returns an error if the triangle index is out of bounds or the triangle is degenerate.
+func (t *Triangulation) Circumcenter(tIdx int) (s2.Point, error) {
+	if tIdx < 0 || tIdx >= len(t.Triangles) {
+		return s2.Point{}, fmt.Errorf("Circumcenter: tIdx %d %w [0 %d)", tIdx, ErrOutOfRange,
+			len(t.Triangles))
+	}
+	tri := t.Triangles[tIdx]
+	return Circumcenter(t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]])
+}
+
+// Circumradius returns the angular radius of the circumcircle of the triangle at the given
+// index, the distance from its Circumcenter to any of its vertices.
+// It returns an error if the triangle index is out of bounds or the triangle is degenerate.
+func (t *Triangulation) Circumradius(tIdx int) (s1.Angle, error) {
+	c, err := t.Circumcenter(tIdx)
+	if err != nil {
+		return 0, fmt.Errorf("Circumradius: %w", err)
+	}
+	return c.Distance(t.Vertices[t.Triangles[tIdx][0]]), nil
+}
+
+// TotalArea returns the sum of the triangle areas in steradians, which is 4π up to rounding for
+// a complete triangulation.
+func (t *Triangulation) TotalArea() float64 {
+	total := 0.0
+	for _, tri := range t.Triangles {
+		total += SphericalTriangleArea(t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]])
+	}
+	return total
+}
+
+// TrianglesInCap returns the indices of triangles whose circumcenter lies within the cap,
+// in ascending order.
+func (t *Triangulation) TrianglesInCap(c s2.Cap) []int {
+	var indices []int
+	for i, tri := range t.Triangles {
+		cc, err := Circumcenter(t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]])
+		if err == nil && c.ContainsPoint(cc) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// sortTriangleVerticesCCW sorts triangle vertices in CCW order, deciding the orientation
+// exactly like Circumcenter so that the circumcenter is always on the CCW side.
+func sortTriangleVerticesCCW(t *Triangle, v s2.PointVector) {
+	if s2.RobustSign(v[t[0]], v[t[1]], v[t[2]]) == s2.Clockwise {
 		t[1], t[2] = t[2], t[1]
 	}
 }
 
 // sortIncidentTriangleIndicesCCW sorts incident triangle indices in CCW order.
-func sortIncidentTriangleIndicesCCW(vIdx int, incidentTris []int, tris [][3]int) {
+func sortIncidentTriangleIndicesCCW(vIdx int, incidentTris []int, tris []Triangle) {
 	n := len(incidentTris)
 	for i := 1; i < n; i++ {
-		nxt, err := NextVertex(tris[incidentTris[i-1]], vIdx)
-		if err != nil {
-			panic(err)
+		nxt := tris[incidentTris[i-1]].NextVertex(vIdx)
+		if nxt < 0 {
+			panic(fmt.Errorf("sortIncidentTriangleIndicesCCW: vIdx %d %w", vIdx,
+				ErrNotInTriangle))
 		}
 		for j := i + 1; j < n; j++ {
-			prv, err := PrevVertex(tris[incidentTris[j]], vIdx)
-			if err != nil {
-				panic(err)
-			}
-			if nxt == prv {
+			if tris[incidentTris[j]].PrevVertex(vIdx) == nxt {
 				incidentTris[i], incidentTris[j] = incidentTris[j], incidentTris[i]
 				break
 			}
@@ -172,30 +601,22 @@ func sortIncidentTriangleIndicesCCW(vIdx int, incidentTris []int, tris [][3]int)
 	}
 }
 
-// PrevVertex returns the previous vertex in the triangle relative to the given vertex index.
+// PrevVertex returns the previous vertex in the triangle relative to the given vertex index,
+// like Triangle.PrevVertex.
 // It returns an error if the vertex index is not part of the triangle.
 func PrevVertex(t [3]int, vIdx int) (int, error) {
-	switch vIdx {
-	case t[0]:
-		return t[2], nil
-	case t[1]:
-		return t[0], nil
-	case t[2]:
-		return t[1], nil
+	if prev := Triangle(t).PrevVertex(vIdx); prev >= 0 {
+		return prev, nil
 	}
-	return 0, fmt.Errorf("PrevVertex: vIdx %d not in triangle", vIdx)
+	return 0, fmt.Errorf("PrevVertex: vIdx %d %w", vIdx, ErrNotInTriangle)
 }
 
-// NextVertex returns the next vertex in the triangle relative to the given vertex index.
+// NextVertex returns the next vertex in the triangle relative to the given vertex index,
+// like Triangle.NextVertex.
 // It returns an error if the vertex index is not part of the triangle.
 func NextVertex(t [3]int, vIdx int) (int, error) {
-	switch vIdx {
-	case t[0]:
-		return t[1], nil
-	case t[1]:
-		return t[2], nil
-	case t[2]:
-		return t[0], nil
+	if next := Triangle(t).NextVertex(vIdx); next >= 0 {
+		return next, nil
 	}
-	return 0, fmt.Errorf("NextVertex: vIdx %d not in triangle", vIdx)
+	return 0, fmt.Errorf("NextVertex: vIdx %d %w", vIdx, ErrNotInTriangle)
 }
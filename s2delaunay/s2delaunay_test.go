@@ -5,14 +5,18 @@
 package s2delaunay
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"testing"
 
 	"github.com/2dChan/s2voronoi/utils"
 	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/markus-wa/quickhull-go/v2"
 )
 
@@ -77,16 +81,260 @@ func TestNewTriangulation_DegenerateInput(t *testing.T) {
 	}
 }
 
-func TestNewTriangulation_VerticesOnSphere(t *testing.T) {
-	dt := mustNewTriangulation(t, 100)
+func TestNewTriangulation_InvalidVertex(t *testing.T) {
+	tests := []struct {
+		name   string
+		vertex s2.Point
+	}{
+		{"nan", s2.Point{Vector: r3.Vector{X: math.NaN(), Y: 0, Z: 1}}},
+		{"positive inf", s2.Point{Vector: r3.Vector{X: 0, Y: math.Inf(1), Z: 0}}},
+		{"negative inf", s2.Point{Vector: r3.Vector{X: 0, Y: 0, Z: math.Inf(-1)}}},
+		{"zero", s2.Point{}},
+		{"off sphere", s2.Point{Vector: r3.Vector{X: 0, Y: 0, Z: 1.001}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vertices := utils.GenerateRandomPoints(10, 0)
+			vertices[7] = tt.vertex
+			_, err := NewTriangulation(vertices, WithDeduplication(0))
+			var ve *VertexError
+			if !errors.As(err, &ve) {
+				t.Fatalf("NewTriangulation(...) error = %v, want VertexError", err)
+			}
+			if ve.Index != 7 {
+				t.Errorf("ve.Index = %d, want 7", ve.Index)
+			}
+			if !errors.Is(err, ErrInvalidVertex) {
+				t.Errorf("errors.Is(%v, ErrInvalidVertex) = false, want true", err)
+			}
+		})
+	}
+}
+
+func TestNewTriangulation_SmallEps(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(1000, 0)
+	for i := range 10 {
+		vertices = append(vertices,
+			s2.PointFromLatLng(s2.LatLngFromDegrees(float64(17*i-80), float64(31*i))))
+	}
+	for _, eps := range []float64{1e-16, 1e-17} {
+		if _, err := NewTriangulation(vertices, WithEps(eps)); err != nil {
+			t.Errorf("NewTriangulation(..., WithEps(%v)) error = %v, want nil", eps, err)
+		}
+	}
+}
+
+func TestNewTriangulationFromHullIndices(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(100, 0)
+	want, err := NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
 
-	for i, p := range dt.Vertices {
-		norm := p.Norm()
-		if math.Abs(norm-1.0) > defaultEps {
-			t.Errorf(
-				"dt.Vertices[%d] norm = %v, want ~1.0", i,
-				norm)
+	// Flip every other triangle to check that orientation is normalized.
+	indices := make([]int, 0, 3*len(want.Triangles))
+	for i, tri := range want.Triangles {
+		if i%2 == 1 {
+			tri[1], tri[2] = tri[2], tri[1]
 		}
+		indices = append(indices, tri[:]...)
+	}
+	got, err := NewTriangulationFromHullIndices(vertices, indices, defaultEps)
+	if err != nil {
+		t.Fatalf("NewTriangulationFromHullIndices(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(Triangulation{})); diff != "" {
+		t.Errorf("NewTriangulationFromHullIndices(...) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewTriangulationFromHullIndices_InvalidInput(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(4, 0)
+	valid := []int{0, 1, 2, 0, 2, 3, 0, 3, 1, 1, 3, 2}
+	tests := []struct {
+		name     string
+		vertices s2.PointVector
+		indices  []int
+		eps      float64
+	}{
+		{"too few vertices", vertices[:3], []int{0, 1, 2, 0, 2, 1}, defaultEps},
+		{"eps zero", vertices, valid, 0},
+		{"wrong count", vertices, valid[:9], defaultEps},
+		{"out of range", vertices, []int{0, 1, 2, 0, 2, 3, 0, 3, 1, 1, 3, 4}, defaultEps},
+		{"degenerate", vertices, []int{0, 1, 2, 0, 2, 3, 0, 3, 1, 1, 1, 2}, defaultEps},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTriangulationFromHullIndices(tt.vertices, tt.indices, tt.eps); err == nil {
+				t.Errorf("NewTriangulationFromHullIndices(...) error = nil, want non-nil")
+			}
+		})
+	}
+}
+
+func TestNewTriangulation_WithPartialResults(t *testing.T) {
+	vertices := utils.GenerateRandomPoints(100, 0)
+	want, err := NewTriangulation(vertices)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	got, err := NewTriangulation(vertices, WithPartialResults())
+	if err != nil {
+		t.Fatalf("NewTriangulation(..., WithPartialResults()) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(Triangulation{})); diff != "" {
+		t.Errorf("NewTriangulation(..., WithPartialResults()) mismatch (-want +got):\n%s", diff)
+	}
+
+	coplanar := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(0, 1, 0), s2.PointFromCoords(-1, 0, 0),
+		s2.PointFromCoords(0, -1, 0), s2.PointFromCoords(0.6, 0.8, 0),
+	}
+	if _, err := NewTriangulation(coplanar); err == nil {
+		t.Fatalf("NewTriangulation(coplanar) error = nil, want non-nil")
+	}
+	dt, err := NewTriangulation(coplanar, WithPartialResults())
+	if err != nil {
+		t.Fatalf("NewTriangulation(coplanar, WithPartialResults()) error = %v, want nil", err)
+	}
+	if !dt.Partial {
+		t.Errorf("dt.Partial = false, want true")
+	}
+	if len(dt.Triangles) == 0 {
+		t.Errorf("len(dt.Triangles) = 0, want > 0")
+	}
+	for i, tri := range dt.Triangles {
+		if tri[0] == tri[1] || tri[1] == tri[2] || tri[2] == tri[0] {
+			t.Errorf("dt.Triangles[%d] = %v has repeated vertices", i, tri)
+		}
+	}
+	if n := len(dt.IncidentTriangleIndices); n != 3*len(dt.Triangles) {
+		t.Errorf("len(dt.IncidentTriangleIndices) = %d, want %d", n, 3*len(dt.Triangles))
+	}
+}
+
+func TestNewTriangulation_Graticule(t *testing.T) {
+	tests := []struct {
+		name             string
+		latStep, lngStep float64
+	}{
+		{"coarse", 30, 45},
+		{"fine", 5, 5},
+		{"uneven", 7, 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := utils.GenerateGraticulePoints(tt.latStep, tt.lngStep)
+			dt, err := NewTriangulation(points)
+			if err != nil {
+				t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+			}
+			if err := dt.checkStructure(); err != nil {
+				t.Fatalf("dt.checkStructure() error = %v, want nil", err)
+			}
+			if got, want := len(dt.Vertices), len(points); got != want {
+				t.Errorf("len(dt.Vertices) = %d, want %d", got, want)
+			}
+			for i := range dt.Triangles {
+				p, _ := dt.TriangleVertices(i)
+				if s2.RobustSign(p[0], p[1], p[2]) != s2.CounterClockwise {
+					t.Errorf("dt.Triangles[%d] = %v is not CCW", i, dt.Triangles[i])
+				}
+			}
+			ring := int(math.Ceil(360/tt.lngStep - 1e-9))
+			for _, pole := range []int{0, len(points) - 1} {
+				incident, _ := dt.IncidentTriangles(pole)
+				if len(incident) != ring {
+					t.Errorf("len(dt.IncidentTriangles(%d)) = %d, want %d", pole, len(incident),
+						ring)
+				}
+			}
+		})
+	}
+}
+
+func TestNewTriangulation_CopyInput(t *testing.T) {
+	points := utils.GenerateRandomPoints(200, 0)
+	dt, err := NewTriangulation(points)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	queries := utils.GenerateRandomPoints(50, 1)
+	wantLocated, err := dt.LocateAll(queries)
+	if err != nil {
+		t.Fatalf("dt.LocateAll(...) error = %v, want nil", err)
+	}
+	wantTriangles := trianglePoints(dt)
+
+	for i := range points {
+		points[i] = s2.Point{Vector: points[i].Mul(-1)}
+	}
+	_ = append(points[:10], points[100:]...)
+
+	gotLocated, err := dt.LocateAll(queries)
+	if err != nil {
+		t.Fatalf("dt.LocateAll(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(wantLocated, gotLocated); diff != "" {
+		t.Errorf("dt.LocateAll(...) after mutating the input mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantTriangles, trianglePoints(dt)); diff != "" {
+		t.Errorf("dt.TriangleVertices(...) after mutating the input mismatch (-want +got):\n%s",
+			diff)
+	}
+}
+
+func TestWithBorrowInput(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	borrowed, err := NewTriangulation(points, WithBorrowInput())
+	if err != nil {
+		t.Fatalf("NewTriangulation(..., WithBorrowInput()) error = %v, want nil", err)
+	}
+	if &borrowed.Vertices[0] != &points[0] {
+		t.Errorf("WithBorrowInput() triangulation does not alias the input")
+	}
+	copied, err := FromMesh(points, borrowed.Triangles)
+	if err != nil {
+		t.Fatalf("FromMesh(...) error = %v, want nil", err)
+	}
+	if &copied.Vertices[0] == &points[0] {
+		t.Errorf("FromMesh(...) triangulation aliases the input")
+	}
+}
+
+func TestNewTriangulation_VerticesOnSphere(t *testing.T) {
+	// Scale the vertices off the unit sphere by up to 1e-3, as a simulation might.
+	scaled := utils.GenerateRandomPoints(100, 0)
+	for i := range scaled {
+		scaled[i] = s2.Point{Vector: scaled[i].Mul(1 + 1e-3*math.Sin(float64(i)))}
+	}
+	input := slices.Clone(scaled)
+	normalized, err := NewTriangulation(scaled, WithNormalize(), WithBorrowInput())
+	if err != nil {
+		t.Fatalf("NewTriangulation(..., WithNormalize()) error = %v, want nil", err)
+	}
+	if !slices.Equal(scaled, input) {
+		t.Errorf("NewTriangulation(..., WithNormalize()) modified its input")
+	}
+
+	tests := []struct {
+		name string
+		dt   *Triangulation
+	}{
+		{"unit", mustNewTriangulation(t, 100)},
+		{"normalized", normalized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, p := range tt.dt.Vertices {
+				norm := p.Norm()
+				if math.Abs(norm-1.0) > defaultEps {
+					t.Errorf(
+						"dt.Vertices[%d] norm = %v, want ~1.0", i,
+						norm)
+				}
+			}
+		})
 	}
 }
 
@@ -171,11 +419,43 @@ func TestIncidentTriangles(t *testing.T) {
 	}
 }
 
+func TestIncidentVertices(t *testing.T) {
+	dt := mustNewTriangulation(t, 200)
+	for v := range dt.Vertices {
+		ring, err := dt.IncidentVertices(v)
+		if err != nil {
+			t.Fatalf("dt.IncidentVertices(%d) error = %v, want nil", v, err)
+		}
+		incident, _ := dt.IncidentTriangles(v)
+		if len(ring) != len(incident) {
+			t.Fatalf("len(dt.IncidentVertices(%d)) = %d, want %d", v, len(ring), len(incident))
+		}
+		seen := make(map[int]bool)
+		for k, tIdx := range incident {
+			prev, next := ring[(k+len(ring)-1)%len(ring)], ring[k]
+			if seen[next] || next == v {
+				t.Errorf("dt.IncidentVertices(%d) = %v, want distinct neighbors", v, ring)
+			}
+			seen[next] = true
+			if want := rotateToMin([]int{v, next, prev}); !cmp.Equal(rotateToMin(dt.Triangles[tIdx][:]),
+				want) {
+				t.Errorf("vertex %d: triangle %d = %v, want %v", v, tIdx, dt.Triangles[tIdx], want)
+			}
+		}
+	}
+
+	for _, vIdx := range []int{-1, len(dt.Vertices)} {
+		if _, err := dt.IncidentVertices(vIdx); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.IncidentVertices(%d) error = %v, want ErrOutOfRange", vIdx, err)
+		}
+	}
+}
+
 func TestTriangleVertices(t *testing.T) {
 	points := utils.GenerateRandomPoints(3, 0)
 	dt := &Triangulation{
 		Vertices: s2.PointVector{points[0], points[1], points[2]},
-		Triangles: [][3]int{
+		Triangles: []Triangle{
 			{0, 1, 2},
 		},
 	}
@@ -199,21 +479,228 @@ func TestTriangleVertices(t *testing.T) {
 	}
 }
 
+func TestAdjacentTriangles(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	for tIdx, tri := range dt.Triangles {
+		adj, err := dt.AdjacentTriangles(tIdx)
+		if err != nil {
+			t.Fatalf("dt.AdjacentTriangles(%d) error = %v, want nil", tIdx, err)
+		}
+		for j, a := range adj {
+			if a == tIdx {
+				t.Errorf("dt.AdjacentTriangles(%d)[%d] = %d, want another triangle", tIdx, j, a)
+				continue
+			}
+			other := dt.Triangles[a]
+			for k := 1; k < 3; k++ {
+				if !slices.Contains(other[:], tri[(j+k)%3]) {
+					t.Errorf("triangle %d across vertex %d of %d lacks vertex %d", a, j, tIdx,
+						tri[(j+k)%3])
+				}
+			}
+			if slices.Contains(other[:], tri[j]) {
+				t.Errorf("triangle %d across vertex %d of %d contains it", a, j, tIdx)
+			}
+		}
+	}
+
+	for _, tIdx := range []int{-1, len(dt.Triangles)} {
+		if _, err := dt.AdjacentTriangles(tIdx); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.AdjacentTriangles(%d) error = %v, want ErrOutOfRange", tIdx, err)
+		}
+	}
+}
+
+func TestFaceNormals(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	normals := dt.FaceNormals()
+	if len(normals) != len(dt.Triangles) {
+		t.Fatalf("dt.FaceNormals() len = %d, want %d", len(normals), len(dt.Triangles))
+	}
+	for i, n := range normals {
+		if math.Abs(n.Norm()-1) > defaultEps {
+			t.Errorf("dt.FaceNormals()[%d] norm = %v, want ~1.0", i, n.Norm())
+		}
+		p, err := dt.TriangleVertices(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleVertices(%d) error = %v, want nil", i, err)
+		}
+		centroid := p[0].Add(p[1].Vector).Add(p[2].Vector).Normalize()
+		if n.Dot(centroid) <= 0 {
+			t.Errorf("dt.FaceNormals()[%d] = %v points inward", i, n)
+		}
+	}
+
+	// The faces of a regular tetrahedron are normal to the opposite vertex.
+	dt = mustNewTetrahedron(t)
+	for i, n := range dt.FaceNormals() {
+		tri := dt.Triangles[i]
+		for v := range dt.Vertices {
+			if v == tri[0] || v == tri[1] || v == tri[2] {
+				continue
+			}
+			if got := n.Dot(dt.Vertices[v].Vector); math.Abs(got+1) > 1e-9 {
+				t.Errorf("dt.FaceNormals()[%d] dot opposite vertex = %v, want -1", i, got)
+			}
+		}
+	}
+}
+
+func TestTriangleArea(t *testing.T) {
+	dt := mustNewTetrahedron(t)
+	for i := range dt.Triangles {
+		got, err := dt.TriangleArea(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleArea(%d) error = %v, want nil", i, err)
+		}
+		if math.Abs(got-math.Pi) > 1e-12 {
+			t.Errorf("dt.TriangleArea(%d) = %v, want %v", i, got, math.Pi)
+		}
+	}
+	for _, tIdx := range []int{-1, len(dt.Triangles)} {
+		if _, err := dt.TriangleArea(tIdx); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.TriangleArea(%d) error = %v, want ErrOutOfRange", tIdx, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		c    s2.Point
+	}{
+		{"collinear", s2.PointFromCoords(1, 1, 0)},
+		{"sliver", s2.PointFromCoords(1, 1, 1e-12)},
+		{"coincident", s2.PointFromCoords(1, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dt := &Triangulation{
+				Vertices: s2.PointVector{
+					s2.PointFromCoords(1, 0, 0), s2.PointFromCoords(0, 1, 0), tt.c,
+				},
+				Triangles: []Triangle{{0, 1, 2}},
+			}
+			got, err := dt.TriangleArea(0)
+			if err != nil {
+				t.Fatalf("dt.TriangleArea(0) error = %v, want nil", err)
+			}
+			if !(got >= 0 && got < 1e-9) {
+				t.Errorf("dt.TriangleArea(0) = %v, want in [0, 1e-9)", got)
+			}
+		})
+	}
+}
+
+func TestTriangleArea_Girard(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	total := 0.0
+	for i, tri := range dt.Triangles {
+		got, err := dt.TriangleArea(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleArea(%d) error = %v, want nil", i, err)
+		}
+		want := s2.GirardArea(dt.Vertices[tri[0]], dt.Vertices[tri[1]], dt.Vertices[tri[2]])
+		if math.Abs(got-want) > 1e-12 {
+			t.Errorf("dt.TriangleArea(%d) = %v, want Girard area %v", i, got, want)
+		}
+		total += got
+	}
+	if got := dt.TotalArea(); got != total {
+		t.Errorf("dt.TotalArea() = %v, want sum of TriangleArea %v", got, total)
+	}
+}
+
+func TestTriangulation_Circumcenter(t *testing.T) {
+	dt := mustNewTriangulation(t, 500)
+	for i := range dt.Triangles {
+		c, err := dt.Circumcenter(i)
+		if err != nil {
+			t.Fatalf("dt.Circumcenter(%d) error = %v, want nil", i, err)
+		}
+		r, err := dt.Circumradius(i)
+		if err != nil {
+			t.Fatalf("dt.Circumradius(%d) error = %v, want nil", i, err)
+		}
+		p, _ := dt.TriangleVertices(i)
+		for j := range 3 {
+			if d := c.Distance(p[j]); math.Abs((d - r).Radians()) > 1e-12 {
+				t.Fatalf("dt.Circumcenter(%d) distance to vertex %d = %v, want %v", i, j, d, r)
+			}
+		}
+		if r <= 0 || r >= math.Pi/2 {
+			t.Errorf("dt.Circumradius(%d) = %v, want in (0, π/2)", i, r)
+		}
+	}
+
+	// The faces of a regular tetrahedron are centered on the antipodes of the opposite vertices.
+	dt = mustNewTetrahedron(t)
+	want := s1.Angle(math.Acos(1.0 / 3))
+	for i := range dt.Triangles {
+		if r, _ := dt.Circumradius(i); math.Abs((r - want).Radians()) > 1e-12 {
+			t.Errorf("dt.Circumradius(%d) = %v, want %v", i, r, want)
+		}
+	}
+	for _, tIdx := range []int{-1, len(dt.Triangles)} {
+		if _, err := dt.Circumcenter(tIdx); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.Circumcenter(%d) error = %v, want ErrOutOfRange", tIdx, err)
+		}
+		if _, err := dt.Circumradius(tIdx); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("dt.Circumradius(%d) error = %v, want ErrOutOfRange", tIdx, err)
+		}
+	}
+}
+
+func TestTotalArea(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	if got := dt.TotalArea(); math.Abs(got-4*math.Pi) > 1e-10 {
+		t.Errorf("dt.TotalArea() = %v, want %v", got, 4*math.Pi)
+	}
+}
+
+func TestTrianglesInCap(t *testing.T) {
+	dt := mustNewTriangulation(t, 1000)
+	c := s2.CapFromCenterAngle(s2.PointFromCoords(1, 1, 0), s1.Angle(0.3))
+
+	got := dt.TrianglesInCap(c)
+	var want []int
+	for i := range dt.Triangles {
+		p, err := dt.TriangleVertices(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleVertices(%d) error = %v, want nil", i, err)
+		}
+		if cc, _ := Circumcenter(p[0], p[1], p[2]); c.ContainsPoint(cc) {
+			want = append(want, i)
+		}
+	}
+	if len(want) == 0 {
+		t.Fatalf("no triangles in test cap")
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("dt.TrianglesInCap(...) mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := dt.TrianglesInCap(s2.FullCap()); len(got) != len(dt.Triangles) {
+		t.Errorf("dt.TrianglesInCap(FullCap) len = %d, want %d", len(got), len(dt.Triangles))
+	}
+	if got := dt.TrianglesInCap(s2.EmptyCap()); len(got) != 0 {
+		t.Errorf("dt.TrianglesInCap(EmptyCap) len = %d, want 0", len(got))
+	}
+}
+
 func TestSortTriangleVerticesCCW(t *testing.T) {
 	a := s2.PointFromCoords(1, 0, 0)
 	b := s2.PointFromCoords(0, 1, 0)
 	c := s2.PointFromCoords(0, 0, 1)
 	verts := s2.PointVector{a, b, c}
 
-	want1 := [3]int{0, 1, 2}
-	tri1 := [3]int{0, 1, 2}
+	want1 := Triangle{0, 1, 2}
+	tri1 := Triangle{0, 1, 2}
 	sortTriangleVerticesCCW(&tri1, verts)
 	if diff := cmp.Diff(want1, tri1); diff != "" {
 		t.Errorf("sortTriangleVerticesCCW([0 1 2], verts) mismatch (-want +got):\n%s", diff)
 	}
 
-	want2 := [3]int{0, 1, 2}
-	tri2 := [3]int{0, 2, 1}
+	want2 := Triangle{0, 1, 2}
+	tri2 := Triangle{0, 2, 1}
 	sortTriangleVerticesCCW(&tri2, verts)
 	if diff := cmp.Diff(want2, tri2); diff != "" {
 		t.Errorf("sortTriangleVerticesCCW([0 2 1], verts) mismatch (-want +got):\n%s", diff)
@@ -223,7 +710,7 @@ func TestSortTriangleVerticesCCW(t *testing.T) {
 func TestSortIncidentTriangleIndicesCCW(t *testing.T) {
 	expected3 := []int{0, 2, 1}
 	incident3 := []int{0, 1, 2}
-	tris3 := [][3]int{
+	tris3 := []Triangle{
 		{0, 1, 2},
 		{0, 2, 3},
 		{0, 3, 1},
@@ -235,7 +722,7 @@ func TestSortIncidentTriangleIndicesCCW(t *testing.T) {
 
 	expected4 := []int{1, 0, 3, 2}
 	incident4 := []int{1, 3, 2, 0}
-	tris4 := [][3]int{
+	tris4 := []Triangle{
 		{0, 1, 2},
 		{0, 2, 3},
 		{0, 3, 4},
@@ -285,6 +772,24 @@ func TestNextVertex(t *testing.T) {
 	}
 }
 
+func TestTriangle_PrevNextVertex(t *testing.T) {
+	tri := Triangle{1, 2, 3}
+	for i, in := range tri {
+		if got, want := tri.PrevVertex(in), tri[(i+2)%3]; got != want {
+			t.Errorf("%v.PrevVertex(%d) = %d, want %d", tri, in, got, want)
+		}
+		if got, want := tri.NextVertex(in), tri[(i+1)%3]; got != want {
+			t.Errorf("%v.NextVertex(%d) = %d, want %d", tri, in, got, want)
+		}
+	}
+	if got := tri.PrevVertex(4); got != -1 {
+		t.Errorf("%v.PrevVertex(4) = %d, want -1", tri, got)
+	}
+	if got := tri.NextVertex(4); got != -1 {
+		t.Errorf("%v.NextVertex(4) = %d, want -1", tri, got)
+	}
+}
+
 // Benchmarks
 
 func BenchmarkConvexHull(b *testing.B) {
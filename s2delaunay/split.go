@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+// AttributeInterpolator is called after a vertex is inserted at fraction t along the edge from
+// a to b, with the index of the new vertex.
+type AttributeInterpolator func(a, b int, t float64, newIdx int)
+
+// SplitOptions holds configuration options for splitting edges.
+type SplitOptions struct {
+	// AttributeInterpolator, if set, is notified of every inserted vertex.
+	AttributeInterpolator AttributeInterpolator
+}
+
+// SplitOption is a functional option type for edge split configuration.
+type SplitOption func(*SplitOptions) error
+
+// WithAttributeInterpolator notifies fn of every vertex inserted by SplitEdge, so that callers
+// can extend per-vertex attribute arrays in lockstep, for example by appending
+// (1-t)·attr[a] + t·attr[b].
+func WithAttributeInterpolator(fn AttributeInterpolator) SplitOption {
+	return func(o *SplitOptions) error {
+		if fn == nil {
+			return &OptionError{Name: "WithAttributeInterpolator",
+				Err: fmt.Errorf("%w: fn must not be nil", ErrInvalidOption)}
+		}
+		o.AttributeInterpolator = fn
+		return nil
+	}
+}
+
+// SplitEdge inserts a vertex at fraction frac of the geodesic from vertex a to vertex b, which
+// must be joined by an edge, splitting the two triangles sharing the edge into four, then
+// restores the Delaunay property by edge flips around the new vertex. The new vertex is
+// appended to Vertices and its index returned. The triangle and incidence arrays are rebuilt
+// in place, so triangle indices change.
+// It returns an error if an option is invalid, the triangulation is partial, a or b is out of
+// range, frac is not in (0, 1), or a and b are not joined by an edge.
+func (t *Triangulation) SplitEdge(a, b int, frac float64, setters ...SplitOption) (int,
+	error) {
+	var opts SplitOptions
+	for _, set := range setters {
+		err := set(&opts)
+		if err != nil {
+			return -1, err
+		}
+	}
+	if t.Partial {
+		return -1, fmt.Errorf("SplitEdge: %w: triangulation is partial", ErrInvalidMesh)
+	}
+	for _, v := range []int{a, b} {
+		if v < 0 || v >= len(t.Vertices) {
+			return -1, fmt.Errorf("SplitEdge: vertex %d %w [0 %d)", v, ErrOutOfRange,
+				len(t.Vertices))
+		}
+	}
+	if !(frac > 0 && frac < 1) {
+		return -1, fmt.Errorf("SplitEdge: %w: frac must be in (0 1) got %v", ErrInvalidOption, frac)
+	}
+
+	m := newFlipMesh(t.Triangles)
+	n := len(t.Vertices)
+	if ok, err := m.split(a, b, n); err != nil {
+		return -1, fmt.Errorf("SplitEdge: %w", err)
+	} else if !ok {
+		return -1, fmt.Errorf("SplitEdge: edge %d-%d %w", a, b, ErrNotFound)
+	}
+	// The vertex slice may be shared with the caller, so it is never appended to in place.
+	p := s2.Interpolate(frac, t.Vertices[a], t.Vertices[b])
+	vertices := append(slices.Clip(t.Vertices), p)
+
+	var stack [][2]int
+	for _, tri := range m.tris {
+		if slices.Contains(tri[:], n) {
+			for j := range 3 {
+				if tri[j] != n && tri[(j+1)%3] != n {
+					stack = append(stack, [2]int{tri[j], tri[(j+1)%3]})
+				}
+			}
+		}
+	}
+	if _, _, err := m.lawson(vertices, stack, -1); err != nil {
+		return -1, fmt.Errorf("SplitEdge: %w", err)
+	}
+
+	old := t.Vertices
+	t.Vertices = vertices
+	if err := t.setTriangles(m.tris); err != nil {
+		t.Vertices = old
+		return -1, fmt.Errorf("SplitEdge: %w", err)
+	}
+	if opts.AttributeInterpolator != nil {
+		opts.AttributeInterpolator(a, b, frac, n)
+	}
+	return n, nil
+}
+
+// split inserts vertex n on the edge between a and b, turning triangles (a, b, c) and
+// (b, a, d) into (a, n, c), (n, b, c), (b, n, d) and (n, a, d). It reports false without
+// changes if the edge does not exist.
+// It returns an error wrapping ErrInvalidMesh if the mesh is inconsistent.
+func (m *flipMesh) split(a, b, n int) (bool, error) {
+	a, b, c, d, ok, err := m.quad(a, b)
+	if err != nil || !ok {
+		return false, err
+	}
+	faces := m.edges[edgeKey(a, b)]
+	f, g := faces[0], faces[1]
+	h, k := len(m.tris), len(m.tris)+1
+	delete(m.edges, edgeKey(a, b))
+	m.tris[f] = [3]int{a, n, c}
+	m.tris[g] = [3]int{b, n, d}
+	m.tris = append(m.tris, [3]int{n, b, c}, [3]int{n, a, d})
+	for _, tIdx := range []int{f, g, h, k} {
+		tri := m.tris[tIdx]
+		for j := range 3 {
+			m.setEdge(tri[j], tri[(j+1)%3], tIdx)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Split
+
+func TestSplitEdge(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	dt, err := NewTriangulation(points)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	attr := make([]float64, len(dt.Vertices))
+	for i, p := range dt.Vertices {
+		attr[i] = p.Z
+	}
+	interp := WithAttributeInterpolator(func(a, b int, frac float64, newIdx int) {
+		if newIdx != len(attr) {
+			t.Fatalf("interpolator newIdx = %d, want %d", newIdx, len(attr))
+		}
+		attr = append(attr, (1-frac)*attr[a]+frac*attr[b])
+	})
+
+	rng := rand.New(rand.NewSource(1))
+	for i := range 200 {
+		tri := dt.Triangles[rng.Intn(len(dt.Triangles))]
+		j := rng.Intn(3)
+		a, b := tri[j], tri[(j+1)%3]
+		frac := 0.1 + 0.8*rng.Float64()
+
+		n, err := dt.SplitEdge(a, b, frac, interp)
+		if err != nil {
+			t.Fatalf("split %d: dt.SplitEdge(%d, %d, %v) error = %v, want nil", i, a, b, frac,
+				err)
+		}
+		if n != len(dt.Vertices)-1 || len(attr) != len(dt.Vertices) {
+			t.Fatalf("split %d: new vertex %d, %d vertices, %d attributes", i, n,
+				len(dt.Vertices), len(attr))
+		}
+		want := s2.Interpolate(frac, dt.Vertices[a], dt.Vertices[b])
+		if dt.Vertices[n] != want {
+			t.Errorf("split %d: dt.Vertices[%d] = %v, want %v", i, n, dt.Vertices[n], want)
+		}
+		if err := dt.checkStructure(); err != nil {
+			t.Fatalf("split %d: dt.checkStructure() error = %v, want nil", i, err)
+		}
+		if _, err := dt.MakeDelaunay(0); err != nil {
+			t.Fatalf("split %d: triangulation is not Delaunay: %v", i, err)
+		}
+	}
+	if len(points) != 100 {
+		t.Errorf("len(points) = %d after splits, want 100", len(points))
+	}
+	for i, p := range dt.Vertices {
+		if math.Abs(attr[i]-p.Z) > 0.1 {
+			t.Errorf("attr[%d] = %v, want ≈ %v", i, attr[i], p.Z)
+		}
+	}
+}
+
+func TestSplitEdge_Invalid(t *testing.T) {
+	dt := mustNewTriangulation(t, 100)
+	tri := dt.Triangles[0]
+	var nonEdge int
+	for v := range dt.Vertices {
+		if v != tri[0] && len(sharedTriangles(dt, tri[0], v)) == 0 {
+			nonEdge = v
+			break
+		}
+	}
+
+	tests := []struct {
+		name string
+		a, b int
+		frac float64
+		want error
+	}{
+		{"zero", tri[0], tri[1], 0, ErrInvalidOption},
+		{"one", tri[0], tri[1], 1, ErrInvalidOption},
+		{"nan", tri[0], tri[1], math.NaN(), ErrInvalidOption},
+		{"out of range", tri[0], len(dt.Vertices), 0.5, ErrOutOfRange},
+		{"not an edge", tri[0], nonEdge, 0.5, ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := dt.SplitEdge(tt.a, tt.b, tt.frac)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("dt.SplitEdge(%d, %d, %v) error = %v, want %v", tt.a, tt.b, tt.frac,
+					err, tt.want)
+			}
+		})
+	}
+	if _, err := dt.SplitEdge(tri[0], tri[1], 0.5, WithAttributeInterpolator(nil)); err == nil {
+		t.Errorf("dt.SplitEdge(..., WithAttributeInterpolator(nil)) error = nil, want non-nil")
+	}
+}
+
+// sharedTriangles returns the triangles incident to both vertices.
+func sharedTriangles(dt *Triangulation, a, b int) []int {
+	var out []int
+	for tIdx, tri := range dt.Triangles {
+		hasA, hasB := false, false
+		for _, v := range tri {
+			hasA = hasA || v == a
+			hasB = hasB || v == b
+		}
+		if hasA && hasB {
+			out = append(out, tIdx)
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Stats summarizes the size and shape of a triangulation. Areas are in steradians, and edge
+// lengths and angles are measured on the sphere.
+type Stats struct {
+	// Vertices, Edges and Triangles are the element counts.
+	Vertices  int
+	Edges     int
+	Triangles int
+
+	// MinArea, MaxArea and MeanArea describe the triangle areas.
+	MinArea  float64
+	MaxArea  float64
+	MeanArea float64
+
+	// MinEdge, MaxEdge and MeanEdge describe the geodesic edge lengths.
+	MinEdge  s1.Angle
+	MaxEdge  s1.Angle
+	MeanEdge s1.Angle
+
+	// MinAngle is the smallest interior angle of any triangle.
+	MinAngle s1.Angle
+
+	// MinDegree, MaxDegree and MeanDegree describe the number of edges at each vertex.
+	MinDegree  int
+	MaxDegree  int
+	MeanDegree float64
+}
+
+// Stats computes the summary of the triangulation in one pass over the triangles and one over
+// the vertices, without allocating. Each edge is counted by the triangle in which its higher
+// vertex follows its lower one CCW, as in ForEachEdge, and the degree of a vertex is its
+// number of incident triangles, so both are exact for a complete triangulation. The fields of
+// an empty triangulation are zero.
+func (t *Triangulation) Stats() Stats {
+	s := Stats{Vertices: len(t.Vertices), Triangles: len(t.Triangles)}
+	if s.Triangles == 0 {
+		return s
+	}
+	s.MinArea, s.MinEdge, s.MinAngle = math.Inf(1), s1.InfAngle(), s1.InfAngle()
+	totalArea, totalEdge := 0.0, s1.Angle(0)
+	for _, tri := range t.Triangles {
+		p := [3]s2.Point{t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]]}
+		area := SphericalTriangleArea(p[0], p[1], p[2])
+		s.MinArea, s.MaxArea = min(s.MinArea, area), max(s.MaxArea, area)
+		totalArea += area
+		for j := range 3 {
+			s.MinAngle = min(s.MinAngle, s2.Angle(p[(j+2)%3], p[j], p[(j+1)%3]))
+			if tri[j] > tri[(j+1)%3] {
+				continue
+			}
+			l := p[j].Distance(p[(j+1)%3])
+			s.MinEdge, s.MaxEdge = min(s.MinEdge, l), max(s.MaxEdge, l)
+			totalEdge += l
+			s.Edges++
+		}
+	}
+	s.MeanArea = totalArea / float64(s.Triangles)
+	if s.Edges > 0 {
+		s.MeanEdge = totalEdge / s1.Angle(s.Edges)
+	} else {
+		s.MinEdge = 0
+	}
+
+	if s.Vertices > 0 && len(t.IncidentTriangleOffsets) == s.Vertices+1 {
+		s.MinDegree = math.MaxInt
+		for v := range s.Vertices {
+			degree := t.IncidentTriangleOffsets[v+1] - t.IncidentTriangleOffsets[v]
+			s.MinDegree, s.MaxDegree = min(s.MinDegree, degree), max(s.MaxDegree, degree)
+		}
+		s.MeanDegree = float64(len(t.IncidentTriangleIndices)) / float64(s.Vertices)
+	}
+	return s
+}
+
+// String renders the stats on one line for logs, with lengths and angles in degrees.
+func (s Stats) String() string {
+	return fmt.Sprintf("vertices %d edges %d triangles %d "+
+		"area min %.3g max %.3g mean %.3g sr "+
+		"edge min %.3g max %.3g mean %.3g deg min angle %.3g deg "+
+		"degree min %d max %d mean %.3g",
+		s.Vertices, s.Edges, s.Triangles,
+		s.MinArea, s.MaxArea, s.MeanArea,
+		s.MinEdge.Degrees(), s.MaxEdge.Degrees(), s.MeanEdge.Degrees(), s.MinAngle.Degrees(),
+		s.MinDegree, s.MaxDegree, s.MeanDegree)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Stats
+
+func TestStats_Tetrahedron(t *testing.T) {
+	s := mustNewTetrahedron(t).Stats()
+	if s.Vertices != 4 || s.Edges != 6 || s.Triangles != 4 {
+		t.Errorf("counts = %d, %d, %d, want 4, 6, 4", s.Vertices, s.Edges, s.Triangles)
+	}
+	for _, a := range []float64{s.MinArea, s.MaxArea, s.MeanArea} {
+		if math.Abs(a-math.Pi) > 1e-12 {
+			t.Errorf("area = %v, want %v", a, math.Pi)
+		}
+	}
+	edge := math.Acos(-1.0 / 3)
+	for _, l := range []float64{s.MinEdge.Radians(), s.MaxEdge.Radians(), s.MeanEdge.Radians()} {
+		if math.Abs(l-edge) > 1e-12 {
+			t.Errorf("edge = %v, want %v", l, edge)
+		}
+	}
+	if want := 2 * math.Pi / 3; math.Abs(s.MinAngle.Radians()-want) > 1e-9 {
+		t.Errorf("s.MinAngle = %v, want %v", s.MinAngle.Radians(), want)
+	}
+	if s.MinDegree != 3 || s.MaxDegree != 3 || s.MeanDegree != 3 {
+		t.Errorf("degree = %d, %d, %v, want 3, 3, 3", s.MinDegree, s.MaxDegree, s.MeanDegree)
+	}
+}
+
+func TestStats(t *testing.T) {
+	dt := mustNewTriangulation(t, 500)
+	s := dt.Stats()
+	if s.Edges != len(dt.Edges()) {
+		t.Errorf("s.Edges = %d, want %d", s.Edges, len(dt.Edges()))
+	}
+	if want := 6 - 12.0/500; math.Abs(s.MeanDegree-want) > 1e-12 {
+		t.Errorf("s.MeanDegree = %v, want %v", s.MeanDegree, want)
+	}
+	if want := dt.TotalArea() / float64(s.Triangles); math.Abs(s.MeanArea-want) > 1e-15 {
+		t.Errorf("s.MeanArea = %v, want %v", s.MeanArea, want)
+	}
+	if want := slices.Min(dt.LocalFeatureSize()); s.MinEdge.Radians() != want {
+		t.Errorf("s.MinEdge = %v, want %v", s.MinEdge.Radians(), want)
+	}
+	worst, _ := dt.Quality(dt.WorstTriangle())
+	if math.Abs(s.MinAngle.Radians()-worst.MinAngle.Radians()) > 1e-15 {
+		t.Errorf("s.MinAngle = %v, want %v", s.MinAngle, worst.MinAngle)
+	}
+	if !(s.MinArea <= s.MeanArea && s.MeanArea <= s.MaxArea) ||
+		!(s.MinEdge <= s.MeanEdge && s.MeanEdge <= s.MaxEdge) ||
+		!(float64(s.MinDegree) <= s.MeanDegree && s.MeanDegree <= float64(s.MaxDegree)) {
+		t.Errorf("dt.Stats() = %+v, want min <= mean <= max", s)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { dt.Stats() }); allocs != 0 {
+		t.Errorf("dt.Stats() allocates %v times, want 0", allocs)
+	}
+	if str := s.String(); !strings.HasPrefix(str, "vertices 500 edges 1494 triangles 996 ") {
+		t.Errorf("s.String() = %q, want counts first", str)
+	}
+	if got := (&Triangulation{}).Stats(); got != (Stats{}) {
+		t.Errorf("empty Stats() = %+v, want zero", got)
+	}
+}
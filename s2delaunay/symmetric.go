@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// coplanarEps is the distance from the plane of a triangle under which a neighboring vertex
+	// is treated as lying on the same hull facet.
+	coplanarEps = 1e-9
+)
+
+// Involution pairs every vertex and triangle of a centrally symmetric triangulation with its
+// antipodal counterpart. Applying either map twice yields the identity.
+type Involution struct {
+	// Vertices maps each vertex index to the index of its antipode.
+	Vertices []int
+	// Triangles maps each triangle index to the index of the triangle with antipodal vertices.
+	Triangles []int
+}
+
+// NewSymmetricTriangulation creates a triangulation of halfSites and their exact antipodes that
+// is symmetric under the antipodal map. Vertex i < n of the result is halfSites[i] and vertex
+// i+n is its antipode, where n = len(halfSites).
+// The convex hull of a centrally symmetric point set is itself symmetric, but cocircular
+// vertices, such as the four corners of a cube face, may be split along different diagonals in
+// the two hemispheres. Such regions are made symmetric by replacing the triangulation of the
+// region whose smallest vertex index is larger with the antipodal image of its counterpart.
+// The vertices are always a fresh slice, so halfSites may be modified afterwards.
+// It returns an error if the triangulation cannot be constructed or is not symmetric, which
+// happens when halfSites contains a point and its antipode.
+func NewSymmetricTriangulation(halfSites s2.PointVector, setters ...TriangulationOption) (
+	*Triangulation, Involution, error) {
+	n := len(halfSites)
+	vertices := make(s2.PointVector, 2*n)
+	copy(vertices, halfSites)
+	for i, p := range halfSites {
+		vertices[n+i] = s2.Point{Vector: p.Mul(-1)}
+	}
+	t, err := NewTriangulation(vertices, append(slices.Clip(setters), WithBorrowInput())...)
+	if err != nil {
+		return nil, Involution{}, fmt.Errorf("NewSymmetricTriangulation: %w", err)
+	}
+
+	antipode := func(v int) int { return (v + n) % (2 * n) }
+	key := func(tri [3]int) [3]int {
+		slices.Sort(tri[:])
+		return tri
+	}
+	image := func(tri [3]int) [3]int {
+		return key([3]int{antipode(tri[0]), antipode(tri[1]), antipode(tri[2])})
+	}
+
+	index := make(map[[3]int]int, len(t.Triangles))
+	for i, tri := range t.Triangles {
+		index[key(tri)] = i
+	}
+	var asymmetric []int
+	for i, tri := range t.Triangles {
+		if _, ok := index[image(tri)]; !ok {
+			asymmetric = append(asymmetric, i)
+		}
+	}
+
+	if len(asymmetric) > 0 {
+		replaced := make([]bool, len(t.Triangles))
+		var mirrored []Triangle
+		for _, comp := range t.facetComponents(asymmetric) {
+			// Of each asymmetric component and its antipodal counterpart, the one containing the
+			// smaller vertex index is kept and its image replaces the other.
+			minVertex, minImage := 2*n, 2*n
+			for _, tIdx := range comp {
+				for _, v := range t.Triangles[tIdx] {
+					minVertex = min(minVertex, v)
+					minImage = min(minImage, antipode(v))
+				}
+			}
+			for _, tIdx := range comp {
+				if minVertex > minImage {
+					replaced[tIdx] = true
+					continue
+				}
+				tri := t.Triangles[tIdx]
+				mirrored = append(mirrored, [3]int{antipode(tri[0]), antipode(tri[1]),
+					antipode(tri[2])})
+			}
+		}
+
+		flat := make([]int, 0, 3*len(t.Triangles))
+		for i, tri := range t.Triangles {
+			if !replaced[i] {
+				flat = append(flat, tri[:]...)
+			}
+		}
+		for _, tri := range mirrored {
+			flat = append(flat, tri[:]...)
+		}
+		if len(flat) != 3*len(t.Triangles) {
+			return nil, Involution{}, fmt.Errorf(
+				"NewSymmetricTriangulation: %w: cannot symmetrize %d triangles", ErrInvalidHull,
+				len(asymmetric))
+		}
+		t, err = newTriangulation(vertices, flat, t.idLevel)
+		if err != nil {
+			return nil, Involution{}, fmt.Errorf("NewSymmetricTriangulation: %w", err)
+		}
+		clear(index)
+		for i, tri := range t.Triangles {
+			index[key(tri)] = i
+		}
+	}
+
+	inv := Involution{
+		Vertices:  make([]int, 2*n),
+		Triangles: make([]int, len(t.Triangles)),
+	}
+	for v := range inv.Vertices {
+		inv.Vertices[v] = antipode(v)
+	}
+	for i, tri := range t.Triangles {
+		j, ok := index[image(tri)]
+		if !ok {
+			return nil, Involution{}, fmt.Errorf(
+				"NewSymmetricTriangulation: %w: triangle %d has no antipodal counterpart",
+				ErrInvalidHull, i)
+		}
+		inv.Triangles[i] = j
+	}
+	return t, inv, nil
+}
+
+// facetComponents splits the given triangles into groups connected through shared edges whose
+// two triangles lie in a common plane, so that each group covers part of a single hull facet.
+func (t *Triangulation) facetComponents(triangles []int) [][]int {
+	in := make(map[int]bool, len(triangles))
+	for _, tIdx := range triangles {
+		in[tIdx] = true
+	}
+	adj := t.triangleAdjacency()
+	seen := make(map[int]bool, len(triangles))
+	var comps [][]int
+	for _, start := range triangles {
+		if seen[start] {
+			continue
+		}
+		seen[start] = true
+		comp := []int{start}
+		for k := 0; k < len(comp); k++ {
+			for _, nb := range adj[comp[k]] {
+				if in[nb] && !seen[nb] && t.coplanar(comp[k], nb) {
+					seen[nb] = true
+					comp = append(comp, nb)
+				}
+			}
+		}
+		comps = append(comps, comp)
+	}
+	return comps
+}
+
+// coplanar reports whether every vertex of triangle u lies on the plane of triangle v.
+func (t *Triangulation) coplanar(u, v int) bool {
+	a, b, c := t.Vertices[t.Triangles[v][0]], t.Vertices[t.Triangles[v][1]],
+		t.Vertices[t.Triangles[v][2]]
+	normal := b.Sub(a.Vector).Cross(c.Sub(a.Vector)).Normalize()
+	for _, w := range t.Triangles[u] {
+		if math.Abs(normal.Dot(t.Vertices[w].Sub(a.Vector))) > coplanarEps {
+			return false
+		}
+	}
+	return true
+}
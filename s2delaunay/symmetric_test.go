@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s2"
+)
+
+// Symmetric
+
+func TestNewSymmetricTriangulation(t *testing.T) {
+	random := utils.GenerateRandomPoints(200, 0)
+	for i, p := range random {
+		if p.Z < 0 {
+			random[i] = s2.Point{Vector: p.Mul(-1)}
+		}
+	}
+	cube := s2.PointVector{
+		s2.PointFromCoords(1, 1, 1),
+		s2.PointFromCoords(-1, 1, 1),
+		s2.PointFromCoords(-1, -1, 1),
+		s2.PointFromCoords(1, -1, 1),
+	}
+	var graticule s2.PointVector
+	for lat := 0; lat < 90; lat += 30 {
+		for lng := 0; lng < 180; lng += 30 {
+			graticule = append(graticule,
+				s2.PointFromLatLng(s2.LatLngFromDegrees(float64(lat), float64(lng))))
+		}
+	}
+	graticule = append(graticule, s2.PointFromCoords(0, 0, 1))
+
+	tests := []struct {
+		name string
+		half s2.PointVector
+	}{
+		{"random", random},
+		{"cube", cube},
+		{"graticule", graticule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tri, inv, err := NewSymmetricTriangulation(tt.half)
+			if err != nil {
+				t.Fatalf("NewSymmetricTriangulation(...) error = %v, want nil", err)
+			}
+			n := len(tt.half)
+			if got, want := len(tri.Triangles), 2*(2*n-2); got != want {
+				t.Errorf("len(tri.Triangles) = %d, want %d", got, want)
+			}
+			for v, w := range inv.Vertices {
+				if inv.Vertices[w] != v {
+					t.Errorf("inv.Vertices[inv.Vertices[%d]] = %d, want %d", v, inv.Vertices[w], v)
+				}
+				if tri.Vertices[w].Vector != tri.Vertices[v].Mul(-1) {
+					t.Errorf("vertex %d = %v, want antipode of vertex %d", w, tri.Vertices[w], v)
+				}
+			}
+
+			seen := make([]bool, len(tri.Triangles))
+			for i, j := range inv.Triangles {
+				if seen[j] {
+					t.Fatalf("inv.Triangles maps two triangles to %d", j)
+				}
+				seen[j] = true
+				if inv.Triangles[j] != i {
+					t.Errorf("inv.Triangles[inv.Triangles[%d]] = %d, want %d", i, inv.Triangles[j],
+						i)
+				}
+				got := tri.Triangles[j][:]
+				want := []int{inv.Vertices[tri.Triangles[i][0]], inv.Vertices[tri.Triangles[i][1]],
+					inv.Vertices[tri.Triangles[i][2]]}
+				slices.Sort(want)
+				got = slices.Sorted(slices.Values(got))
+				if !slices.Equal(got, want) {
+					t.Errorf("triangle %d = %v, want antipodal image %v of triangle %d", j, got,
+						want, i)
+				}
+			}
+
+			var area float64
+			for i := range tri.Triangles {
+				v, err := tri.TriangleVertices(i)
+				if err != nil {
+					t.Fatalf("TriangleVertices(%d) error = %v, want nil", i, err)
+				}
+				area += s2.SignedArea(v[0], v[1], v[2])
+			}
+			if math.Abs(area-4*math.Pi) > 1e-9 {
+				t.Errorf("total signed area = %v, want %v", area, 4*math.Pi)
+			}
+		})
+	}
+}
+
+func TestNewSymmetricTriangulation_Antipodal(t *testing.T) {
+	half := s2.PointVector{
+		s2.PointFromCoords(1, 0, 0),
+		s2.PointFromCoords(0, 1, 0),
+		s2.PointFromCoords(-1, 0, 0),
+	}
+	if _, _, err := NewSymmetricTriangulation(half); err == nil {
+		t.Errorf("NewSymmetricTriangulation(...) error = nil, want non-nil for antipodal input")
+	}
+}
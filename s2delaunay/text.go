@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/2dChan/s2voronoi/internal/text"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	triangulationTextKey     = "s2delaunay-triangulation"
+	triangulationTextVersion = 1
+)
+
+// MarshalText encodes the triangulation in a line-oriented text format meant for golden files.
+// Coordinates are written as hexadecimal floating-point literals, so they round-trip exactly.
+// Triangles are written in canonical order, each rotated to start at its smallest vertex and
+// sorted lexicographically, and the incidence arrays are left to be rebuilt on decoding, so the
+// output does not depend on the order in which the hull enumerated the triangles.
+// It implements encoding.TextMarshaler.
+func (t *Triangulation) MarshalText() ([]byte, error) {
+	var w text.Writer
+	w.Int(triangulationTextKey, triangulationTextVersion)
+	w.Int("idlevel", t.idLevel)
+	w.Points("vertices", t.Vertices)
+	tris := make([][]int, len(t.Triangles))
+	for i, tri := range t.Triangles {
+		tris[i] = rotateToMin(tri[:])
+	}
+	slices.SortFunc(tris, slices.Compare)
+	w.Int("triangles", len(tris))
+	for _, tri := range tris {
+		w.Ints("t", tri)
+	}
+	return w.Bytes(), nil
+}
+
+// UnmarshalText decodes a triangulation produced by MarshalText, replacing the contents of t.
+// Triangles and incidence arrays are in the canonical order of the text, and the decoded
+// arrays are checked for structural consistency.
+// It implements encoding.TextUnmarshaler and returns an error wrapping ErrInvalidEncoding if
+// the text is malformed.
+func (t *Triangulation) UnmarshalText(data []byte) error {
+	r := text.NewReader(data)
+	if version := r.Int(triangulationTextKey); r.Err() == nil &&
+		version != triangulationTextVersion {
+		return fmt.Errorf("UnmarshalText: %w: unknown version %d", ErrInvalidEncoding, version)
+	}
+	idLevel := r.Int("idlevel")
+	vertices := r.Points("vertices")
+	numTriangles := r.Int("triangles")
+	if r.Err() == nil && numTriangles != 2*(len(vertices)-2) {
+		return fmt.Errorf("UnmarshalText: %w: %d triangles for %d vertices", ErrInvalidEncoding,
+			numTriangles, len(vertices))
+	}
+	var flat []int
+	for range max(numTriangles, 0) {
+		tri := r.Ints("t")
+		if r.Err() != nil {
+			break
+		}
+		if len(tri) != 3 {
+			return fmt.Errorf("UnmarshalText: %w: triangle with %d vertices", ErrInvalidEncoding,
+				len(tri))
+		}
+		for _, v := range tri {
+			if v < 0 || v >= len(vertices) {
+				return fmt.Errorf("UnmarshalText: %w: vertex %d %w [0 %d)", ErrInvalidEncoding, v,
+					ErrOutOfRange, len(vertices))
+			}
+		}
+		flat = append(flat, tri...)
+	}
+	if err := r.Close(); err != nil {
+		return fmt.Errorf("UnmarshalText: %w: %w", ErrInvalidEncoding, err)
+	}
+	if idLevel < 0 || idLevel > s2.MaxLevel {
+		return fmt.Errorf("UnmarshalText: %w: id level %d", ErrInvalidEncoding, idLevel)
+	}
+
+	u, err := newTriangulation(vertices, flat, idLevel)
+	if err == nil {
+		err = u.checkStructure()
+	}
+	if err != nil {
+		return fmt.Errorf("UnmarshalText: %w: %w", ErrInvalidEncoding, err)
+	}
+	*t = Triangulation{
+		Vertices:                u.Vertices,
+		Triangles:               u.Triangles,
+		IncidentTriangleIndices: u.IncidentTriangleIndices,
+		IncidentTriangleOffsets: u.IncidentTriangleOffsets,
+		idLevel:                 idLevel,
+	}
+	t.recordVertices()
+	return nil
+}
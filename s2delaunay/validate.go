@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"fmt"
+
+	"github.com/2dChan/s2voronoi/internal/checksum"
+)
+
+// Validate verifies that the triangulation is a Delaunay triangulation of its vertices:
+//   - the arrays are consistent and the vertices are unit length, as required by
+//     UnmarshalBinary;
+//   - the vertices match the checksum recorded when the triangulation was built by this
+//     package, so that vertices modified in place, for example through shared storage, are
+//     reported;
+//   - every triangle is CCW when looking out of the sphere and every directed edge is matched
+//     by its reverse in another triangle, so that V − E + F = 2;
+//   - the incident triangles of every vertex form a closed CCW cycle;
+//   - no vertex lies inside the circumcap of a triangle by more than eps, measured as the
+//     distance of the vertex beyond the plane of the triangle. With eps zero the test is exact.
+//
+// The empty circumcap property is checked for the vertex across each edge of every triangle,
+// which for a closed triangulation of the sphere implies it for all other vertices.
+// It returns an error describing the first violation, a *DelaunayError naming the triangle and
+// the intruding vertex if the triangulation is valid but not Delaunay, or an error wrapping
+// ErrInvalidOption if eps is negative.
+func (t *Triangulation) Validate(eps float64) error {
+	if eps < 0 {
+		return fmt.Errorf("Validate: %w: eps must not be negative got %v", ErrInvalidOption, eps)
+	}
+	if err := t.checkStructure(); err != nil {
+		return fmt.Errorf("Validate: %w: %w", ErrInvalidMesh, err)
+	}
+	if t.hashed && checksum.Points(t.Vertices) != t.verticesHash {
+		return fmt.Errorf("Validate: %w: vertices were modified after the triangulation was "+
+			"built", ErrInvalidMesh)
+	}
+
+	across := make(map[[2]int]int, 3*len(t.Triangles))
+	for tIdx, tri := range t.Triangles {
+		if meshOrientation(tri, t.Vertices) <= 0 {
+			return fmt.Errorf("Validate: %w: triangle %d is not CCW", ErrInvalidMesh, tIdx)
+		}
+		for j := range 3 {
+			e := [2]int{tri[j], tri[(j+1)%3]}
+			if _, ok := across[e]; ok {
+				return fmt.Errorf("Validate: %w: edge %v is used by more than one triangle",
+					ErrInvalidMesh, e)
+			}
+			across[e] = tIdx
+		}
+	}
+	numEdges := 0
+	for e := range across {
+		if _, ok := across[[2]int{e[1], e[0]}]; !ok {
+			return fmt.Errorf("Validate: %w: edge %v has no triangle on its right",
+				ErrInvalidMesh, e)
+		}
+		if e[0] < e[1] {
+			numEdges++
+		}
+	}
+	if chi := len(t.Vertices) - numEdges + len(t.Triangles); chi != 2 {
+		return fmt.Errorf("Validate: %w: V - E + F = %d, want 2", ErrInvalidMesh, chi)
+	}
+
+	for v := range t.Vertices {
+		incident, _ := t.IncidentTriangles(v)
+		for k, tIdx := range incident {
+			next := t.Triangles[tIdx].NextVertex(v)
+			if prev := t.Triangles[incident[(k+1)%len(incident)]].PrevVertex(v); next != prev {
+				return fmt.Errorf("Validate: %w: incident triangles of vertex %d are not a "+
+					"closed CCW cycle", ErrInvalidMesh, v)
+			}
+		}
+	}
+
+	for tIdx, tri := range t.Triangles {
+		a, b, c := t.Vertices[tri[0]], t.Vertices[tri[1]], t.Vertices[tri[2]]
+		n := b.Sub(a.Vector).Cross(c.Sub(a.Vector)).Normalize()
+		for j := range 3 {
+			nb := across[[2]int{tri[(j+2)%3], tri[(j+1)%3]}]
+			d := t.Triangles[nb].NextVertex(tri[(j+1)%3])
+			var inside bool
+			if eps == 0 {
+				inside = InCircumcap(a, b, c, t.Vertices[d])
+			} else {
+				inside = n.Dot(t.Vertices[d].Sub(a.Vector)) > eps
+			}
+			if inside {
+				return fmt.Errorf("Validate: %w", &DelaunayError{Triangle: tIdx, Vertex: d})
+			}
+		}
+	}
+	return nil
+}
+
+// recordVertices records the checksum of the current vertices for Validate.
+func (t *Triangulation) recordVertices() {
+	t.verticesHash, t.hashed = checksum.Points(t.Vertices), true
+}
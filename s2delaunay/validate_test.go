@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2delaunay
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// Validate
+
+func TestValidate(t *testing.T) {
+	dt := mustNewTriangulation(t, 500)
+	for _, eps := range []float64{0, defaultEps} {
+		if err := dt.Validate(eps); err != nil {
+			t.Errorf("dt.Validate(%v) error = %v, want nil", eps, err)
+		}
+	}
+	if err := dt.Validate(-1); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("dt.Validate(-1) error = %v, want %v", err, ErrInvalidOption)
+	}
+}
+
+func TestValidate_NotDelaunay(t *testing.T) {
+	src := mustNewTriangulation(t, 100)
+	dt, err := FromMesh(src.Vertices, mustScramble(t, src, 100, 1))
+	if err != nil {
+		t.Fatalf("FromMesh(...) error = %v, want nil", err)
+	}
+	err = dt.Validate(0)
+	var de *DelaunayError
+	if !errors.As(err, &de) || !errors.Is(err, ErrNotDelaunay) {
+		t.Fatalf("dt.Validate(0) error = %v, want DelaunayError", err)
+	}
+	tri := dt.Triangles[de.Triangle]
+	a, b, c := dt.Vertices[tri[0]], dt.Vertices[tri[1]], dt.Vertices[tri[2]]
+	if !InCircumcap(a, b, c, dt.Vertices[de.Vertex]) {
+		t.Errorf("vertex %d is not inside the circumcap of triangle %d", de.Vertex, de.Triangle)
+	}
+}
+
+func TestValidate_InvalidMesh(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(dt *Triangulation)
+	}{
+		{"clockwise triangle", func(dt *Triangulation) {
+			dt.Triangles[0][1], dt.Triangles[0][2] = dt.Triangles[0][2], dt.Triangles[0][1]
+		}},
+		{"unsorted ring", func(dt *Triangulation) {
+			incident, _ := dt.IncidentTriangles(0)
+			incident[0], incident[1] = incident[1], incident[0]
+		}},
+		{"duplicate triangle", func(dt *Triangulation) {
+			dt.Triangles[1] = dt.Triangles[0]
+		}},
+		{"modified vertex", func(dt *Triangulation) {
+			dt.Vertices = slices.Clone(dt.Vertices)
+			dt.Vertices[0].X = math.Nextafter(dt.Vertices[0].X, 0)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dt := mustNewTriangulation(t, 50)
+			dt.Triangles = slices.Clone(dt.Triangles)
+			tt.modify(dt)
+			if err := dt.Validate(0); !errors.Is(err, ErrInvalidMesh) {
+				t.Errorf("dt.Validate(0) error = %v, want %v", err, ErrInvalidMesh)
+			}
+		})
+	}
+}
@@ -7,14 +7,20 @@
 package s2voronoi
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"sync/atomic"
 
+	"github.com/2dChan/s2voronoi/internal/buildclock"
 	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 )
 
 const (
-	defaultEps = 1e-12
+	defaultEps               = 1e-12
+	defaultOverrideTolerance = 1e-9
 )
 
 // Diagram represents a Voronoi diagram on the S2 sphere.
@@ -32,13 +38,59 @@ type Diagram struct {
 	CellNeighbors []int
 	// CellOffsets contains offsets for slicing cell data in a CSR-like format.
 	CellOffsets []int
+
+	// Dual records which triangle centers were used as the diagram vertices.
+	Dual DualType
+
+	opts      DiagramOptions
+	cache     atomic.Pointer[diagramCache]
+	sitesHash uint64
 }
 
+// DualType identifies the triangle center used to build the dual of the Delaunay triangulation.
+type DualType int
+
+const (
+	// CircumcentricDual uses triangle circumcenters, producing true Voronoi cells.
+	CircumcentricDual DualType = iota
+	// BarycentricDual uses spherical triangle centroids, producing well-shaped cells that are
+	// not Voronoi cells.
+	BarycentricDual
+)
+
 // DiagramOptions holds configuration options for Voronoi diagram creation.
 type DiagramOptions struct {
+	// Eps is the numerical precision epsilon, 1e-12 unless set by WithEps.
 	Eps float64
+	// AutoEps derives Eps from the site spacing, overriding the Eps field.
+	AutoEps bool
+	// VertexOverride, if set, is asked for the Voronoi vertex of every triangle before the
+	// built-in circumcenter is computed.
+	VertexOverride VertexOverrideFunc
+	// OverrideTolerance bounds the deviation of overridden vertices from unit norm and from
+	// equidistance to the three sites of their triangle.
+	OverrideTolerance s1.Angle
+	// IDLevel is the S2 cell level at which sites are snapped when deriving cell IDs.
+	IDLevel int
+	// MaxRadius, if positive, truncates every cell to the cap of this radius around its site.
+	MaxRadius s1.Angle
+	// SharedStorage makes the diagram alias the triangulation's vertex and incidence arrays
+	// instead of copying them.
+	SharedStorage bool
+	// Metrics, if set, receives the metrics of every build.
+	Metrics *BuildMetrics
+	// RingRepair recomputes the vertices of cells with non-simple rings in extended precision.
+	RingRepair bool
+	// WithoutNeighbors leaves CellNeighbors nil until BuildNeighbors is called.
+	WithoutNeighbors bool
+	// FixOrientation makes FromRings reverse rings of the wrong orientation instead of failing.
+	FixOrientation bool
 }
 
+// VertexOverrideFunc supplies the Voronoi vertex for the triangle with the given vertices and
+// index. It returns false to fall back to the built-in circumcenter.
+type VertexOverrideFunc func(tri [3]s2.Point, tIdx int) (s2.Point, bool)
+
 // DiagramOption is a functional option type for Voronoi diagram configuration.
 type DiagramOption func(*DiagramOptions) error
 
@@ -47,67 +99,277 @@ type DiagramOption func(*DiagramOptions) error
 func WithEps(eps float64) DiagramOption {
 	return func(o *DiagramOptions) error {
 		if eps <= 0 {
-			return fmt.Errorf("WithEps: eps must be positive got %v", eps)
-
+			return &OptionError{Name: "WithEps",
+				Err: fmt.Errorf("%w: eps must be positive got %v", ErrInvalidOption, eps)}
 		}
 		o.Eps = eps
+		o.AutoEps = false
+		return nil
+	}
+}
+
+// WithAutoEps derives the numerical precision epsilon from the site spacing instead of using a
+// fixed value, as described by s2delaunay.AutoEps. A later WithEps overrides it.
+func WithAutoEps() DiagramOption {
+	return func(o *DiagramOptions) error {
+		o.AutoEps = true
+		return nil
+	}
+}
+
+// WithVertexOverride sets a callback supplying Voronoi vertices for specific triangles.
+// Overridden vertices are validated against the override tolerance.
+func WithVertexOverride(fn VertexOverrideFunc) DiagramOption {
+	return func(o *DiagramOptions) error {
+		if fn == nil {
+			return &OptionError{Name: "WithVertexOverride",
+				Err: fmt.Errorf("%w: fn must not be nil", ErrInvalidOption)}
+		}
+		o.VertexOverride = fn
+		return nil
+	}
+}
+
+// WithOverrideTolerance sets the tolerance on the spread of the distances from an overridden
+// Voronoi vertex to its sites; the vertex norm is checked against a fixed tolerance instead.
+// It must be positive. Large values allow non-circumcentric vertices such as centroids.
+func WithOverrideTolerance(tol s1.Angle) DiagramOption {
+	return func(o *DiagramOptions) error {
+		if tol <= 0 {
+			return &OptionError{Name: "WithOverrideTolerance",
+				Err: fmt.Errorf("%w: tol must be positive got %v", ErrInvalidOption, tol)}
+		}
+		o.OverrideTolerance = tol
+		return nil
+	}
+}
+
+// WithIDLevel sets the S2 cell level used to derive stable cell IDs. Sites that move within
+// their cell at this level keep their cell IDs.
+// It must be in [0, s2.MaxLevel].
+func WithIDLevel(level int) DiagramOption {
+	return func(o *DiagramOptions) error {
+		if level < 0 || level > s2.MaxLevel {
+			return &OptionError{Name: "WithIDLevel",
+				Err: fmt.Errorf("%w: level must be in [0 %d] got %d",
+					ErrInvalidOption, s2.MaxLevel, level)}
+		}
+		o.IDLevel = level
+		return nil
+	}
+}
+
+// WithSharedStorage makes Sites, CellVertices and CellOffsets alias the Vertices,
+// IncidentTriangleIndices and IncidentTriangleOffsets of the triangulation the diagram is built
+// from, saving a copy of each. Mutating either structure afterwards then changes the other, and
+// the diagram keeps the triangulation's arrays alive. By default they are copied.
+func WithSharedStorage() DiagramOption {
+	return func(o *DiagramOptions) error {
+		o.SharedStorage = true
 		return nil
 	}
 }
 
 // NewDiagram creates a new Voronoi diagram from the given sites.
 // The sites must lie on the unit sphere, there must be at least 4 sites, and they must not be coplanar.
+// The diagram stores a copy of the sites, so they may be modified afterwards.
+// Cocircular sites are resolved as by s2delaunay.NewTriangulation: the Voronoi vertices of the
+// triangles splitting their circle coincide, and which of the sites become neighbors across the
+// resulting zero-length edges follows the split QuickHull makes.
 // It returns an error if the diagram cannot be constructed.
 func NewDiagram(sites s2.PointVector, setters ...DiagramOption) (*Diagram, error) {
+	return newDiagram(sites, CircumcentricDual, setters)
+}
+
+// NewBarycentricDualDiagram creates a dual diagram from the given sites using spherical triangle
+// centroids instead of circumcenters as vertices. Its cells stay well-shaped even when the
+// triangulation has slivers, but sites are not guaranteed to be nearest to their own cell.
+// The sites must satisfy the same requirements as for NewDiagram.
+func NewBarycentricDualDiagram(sites s2.PointVector, setters ...DiagramOption) (*Diagram, error) {
+	return newDiagram(sites, BarycentricDual, setters)
+}
+
+// NewDiagramFromTriangulation creates a new Voronoi diagram dual to an existing Delaunay
+// triangulation, whose vertices become the sites. The Eps and AutoEps options are ignored.
+// The diagram does not retain dt unless WithSharedStorage is given.
+// It returns an error if the triangulation is partial or the diagram cannot be constructed.
+func NewDiagramFromTriangulation(dt *s2delaunay.Triangulation,
+	setters ...DiagramOption) (*Diagram, error) {
+	opts, err := newDiagramOptions(setters)
+	if err != nil {
+		return nil, err
+	}
+	if dt.Partial {
+		return nil, newConstructionError("NewDiagramFromTriangulation",
+			errors.New("partial triangulation"))
+	}
+
+	d := &Diagram{
+		Dual: CircumcentricDual,
+		opts: opts,
+	}
+	clock := d.startMetrics(len(dt.Vertices))
+	defer d.finishMetrics(clock)
+	if err := d.fromTriangulation(dt, clock); err != nil {
+		return nil, newConstructionError("NewDiagramFromTriangulation", err)
+	}
+
+	return d, nil
+}
+
+// newDiagram creates a dual diagram of the given type from the sites.
+func newDiagram(sites s2.PointVector, dual DualType, setters []DiagramOption) (*Diagram, error) {
+	opts, err := newDiagramOptions(setters)
+	if err != nil {
+		return nil, err
+	}
+	if dual == BarycentricDual && opts.RingRepair {
+		return nil, &OptionError{Name: "WithRingRepair",
+			Err: fmt.Errorf("%w: conflicts with BarycentricDual", ErrInvalidOption)}
+	}
+
+	d := &Diagram{
+		Dual: dual,
+		opts: opts,
+	}
+	if err := d.build(sites); err != nil {
+		return nil, newConstructionError("NewDiagram", err)
+	}
+
+	return d, nil
+}
+
+// newDiagramOptions applies the setters to the default diagram options.
+func newDiagramOptions(setters []DiagramOption) (DiagramOptions, error) {
 	opts := DiagramOptions{
-		Eps: defaultEps,
+		Eps:               defaultEps,
+		OverrideTolerance: defaultOverrideTolerance,
+		IDLevel:           s2.MaxLevel,
 	}
 	for _, set := range setters {
 		err := set(&opts)
 		if err != nil {
-			return nil, err
+			return DiagramOptions{}, err
 		}
 	}
+	return opts, nil
+}
+
+// Rebuild recomputes the diagram in place from new sites using the options and dual type the
+// diagram was created with. Existing slices are reused when their capacity allows, so repeated
+// rebuilds with a fixed number of sites avoid reallocating the diagram's own storage. The sites
+// are copied, so they may be modified afterwards.
+// Cell values obtained before the rebuild are invalid afterwards. On error the diagram is left
+// in an unspecified state.
+func (d *Diagram) Rebuild(sites s2.PointVector) error {
+	if err := d.build(sites); err != nil {
+		return newConstructionError("Rebuild", err)
+	}
+	return nil
+}
 
-	dt, err := s2delaunay.NewTriangulation(sites, s2delaunay.WithEps(opts.Eps))
+// build fills the diagram from the Delaunay triangulation of the sites.
+func (d *Diagram) build(sites s2.PointVector) error {
+	clock := d.startMetrics(len(sites))
+	defer d.finishMetrics(clock)
+	d.InvalidateCaches()
+	setters := []s2delaunay.TriangulationOption{s2delaunay.WithEps(d.opts.Eps)}
+	if d.opts.AutoEps {
+		setters[0] = s2delaunay.WithAutoEps()
+	}
+	if clock != nil {
+		setters = append(setters, s2delaunay.WithMetrics(&d.opts.Metrics.Triangulation))
+	}
+	if !d.opts.SharedStorage {
+		// fromTriangulation copies the vertices into Sites, so the triangulation need not.
+		setters = append(setters, s2delaunay.WithBorrowInput())
+	}
+	dt, err := s2delaunay.NewTriangulation(sites, setters...)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return d.fromTriangulation(dt, clock)
+}
 
+// fromTriangulation fills the diagram from the given Delaunay triangulation, copying or
+// aliasing its arrays as configured by the SharedStorage option. The phases are measured into
+// the configured metrics with clock if it is not nil.
+func (d *Diagram) fromTriangulation(dt *s2delaunay.Triangulation,
+	clock *buildclock.Clock) error {
+	m := d.opts.Metrics
+	if clock != nil {
+		clock.Lap()
+	}
 	numTriangles := len(dt.Triangles)
 	numNeighbors := len(dt.IncidentTriangleIndices)
-	d := &Diagram{
-		Sites:         dt.Vertices,
-		Vertices:      make(s2.PointVector, numTriangles),
-		CellVertices:  dt.IncidentTriangleIndices,
-		CellNeighbors: make([]int, numNeighbors),
-		CellOffsets:   dt.IncidentTriangleOffsets,
+	if d.opts.SharedStorage {
+		d.Sites = dt.Vertices
+		d.CellVertices = dt.IncidentTriangleIndices
+		d.CellOffsets = dt.IncidentTriangleOffsets
+	} else {
+		d.Sites = resize(d.Sites, len(dt.Vertices))
+		copy(d.Sites, dt.Vertices)
+		d.CellVertices = resize(d.CellVertices, numNeighbors)
+		copy(d.CellVertices, dt.IncidentTriangleIndices)
+		d.CellOffsets = resize(d.CellOffsets, len(dt.IncidentTriangleOffsets))
+		copy(d.CellOffsets, dt.IncidentTriangleOffsets)
+	}
+	d.recordSites()
+	d.Vertices = resize(d.Vertices, numTriangles)
+	if d.opts.WithoutNeighbors {
+		d.CellNeighbors = nil
+	} else {
+		d.CellNeighbors = resize(d.CellNeighbors, numNeighbors)
+	}
+	if clock != nil {
+		m.Vertices = numTriangles
+		m.Copy = clock.Lap()
 	}
 
 	for i := range numTriangles {
 		p, err := dt.TriangleVertices(i)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if d.opts.VertexOverride != nil {
+			if v, ok := d.opts.VertexOverride(p, i); ok {
+				if err := validateVertex(v, p, d.opts.OverrideTolerance); err != nil {
+					return fmt.Errorf("override for triangle %d: %w", i, err)
+				}
+				d.Vertices[i] = v
+				continue
+			}
 		}
-		d.Vertices[i] = s2.Point{Vector: triangleCircumcenter(p[0], p[1], p[2]).Normalize()}
+		d.Vertices[i] = dualVertex(d.Dual, p)
+	}
+	if clock != nil {
+		m.Circumcenters = clock.Lap()
 	}
 
-	for vIdx := range dt.Vertices {
-		offset := dt.IncidentTriangleOffsets[vIdx]
-		it, err := dt.IncidentTriangles(vIdx)
-		if err != nil {
-			return nil, err
-		}
-		for i, tIdx := range it {
-			nxt, err := s2delaunay.NextVertex(dt.Triangles[tIdx], vIdx)
+	if !d.opts.WithoutNeighbors {
+		for vIdx := range dt.Vertices {
+			offset := dt.IncidentTriangleOffsets[vIdx]
+			it, err := dt.IncidentTriangles(vIdx)
 			if err != nil {
-				return nil, err
+				return err
+			}
+			for i, tIdx := range it {
+				nxt, err := s2delaunay.NextVertex(dt.Triangles[tIdx], vIdx)
+				if err != nil {
+					return err
+				}
+				d.CellNeighbors[offset+i] = nxt
 			}
-			d.CellNeighbors[offset+i] = nxt
 		}
 	}
+	if clock != nil {
+		m.NeighborFill = clock.Lap()
+	}
+	if d.opts.RingRepair {
+		d.repairRings(dt.Triangles)
+	}
 
-	return d, nil
+	return nil
 }
 
 // NumCells returns the number of cells in the diagram.
@@ -119,22 +381,55 @@ func (d *Diagram) NumCells() int {
 // It returns an error if the index is out of range.
 func (d *Diagram) Cell(i int) (Cell, error) {
 	if i < 0 || i >= len(d.Sites) {
-		return Cell{}, fmt.Errorf("Cell: index %d out of range [0, %d)", i, len(d.Sites))
+		return Cell{}, fmt.Errorf("Cell: index %d %w [0 %d)", i, ErrOutOfRange, len(d.Sites))
 	}
 
 	return Cell{idx: i, d: d}, nil
 }
 
-// triangleCircumcenter computes the circumcenter of a triangle on the sphere.
-func triangleCircumcenter(p1, p2, p3 s2.Point) s2.Point {
-	v1 := p1.Sub(p2.Vector)
-	v2 := p2.Sub(p3.Vector)
+// CellTriangles returns the indices of the Delaunay triangles whose dual vertices form the ring
+// of cell i, in the CCW order of the ring: ring vertex j of the cell is the dual vertex of
+// triangle CellTriangles(i)[j], and the triangle is formed by site i and the neighbors on
+// either side of that vertex. The diagram keeps one vertex per triangle at the index of the
+// triangle in the triangulation it was built from, so the result is a copy of the cell's
+// VertexIndices; coincident vertices of cocircular sites stay distinct. Decoding renumbers the
+// vertices together with the triangles, so the contract holds for the triangles in the
+// decoded vertex order.
+// It returns nil if i is out of range.
+func (d *Diagram) CellTriangles(i int) []int {
+	if i < 0 || i >= len(d.Sites) {
+		return nil
+	}
+	return slices.Clone(Cell{idx: i, d: d}.VertexIndices())
+}
 
-	circumcenter := v1.Cross(v2)
+// validateVertex checks that v has unit norm within unitNormTolerance and is equidistant from
+// the triangle vertices within tol.
+func validateVertex(v s2.Point, tri [3]s2.Point, tol s1.Angle) error {
+	if !isUnitPoint(v) {
+		return fmt.Errorf("vertex norm %v is not unit", v.Norm())
+	}
+	d0, d1, d2 := v.Distance(tri[0]), v.Distance(tri[1]), v.Distance(tri[2])
+	if spread := max(d0, d1, d2) - min(d0, d1, d2); spread > tol {
+		return fmt.Errorf("vertex distances to sites differ by %v, exceeding %v", spread, tol)
+	}
+	return nil
+}
 
-	if circumcenter.Dot(p1.Vector.Add(p2.Vector).Add(p3.Vector)) < 0 {
-		circumcenter = circumcenter.Mul(-1)
+// resize returns s with length n, reallocating only when its capacity is insufficient.
+func resize[S ~[]E, E any](s S, n int) S {
+	if cap(s) < n {
+		return make(S, n)
 	}
+	return s[:n]
+}
 
-	return s2.Point{Vector: circumcenter}
+// dualVertex returns the vertex of the dual diagram of the given type for a triangle.
+func dualVertex(dual DualType, p [3]s2.Point) s2.Point {
+	if dual == BarycentricDual {
+		return s2.Point{Vector: s2.TrueCentroid(p[0], p[1], p[2]).Normalize()}
+	}
+	// A triangle with coincident vertices has no unique circumcenter and gets the zero point.
+	c, _ := s2delaunay.Circumcenter(p[0], p[1], p[2])
+	return c
 }
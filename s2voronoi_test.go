@@ -5,13 +5,19 @@
 package s2voronoi
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"testing"
 
+	"github.com/2dChan/s2voronoi/s2delaunay"
 	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 // DiagramOptions
@@ -41,6 +47,55 @@ func TestWithEps(t *testing.T) {
 	}
 }
 
+func TestWithAutoEps(t *testing.T) {
+	opts := &DiagramOptions{Eps: defaultEps}
+	if err := WithAutoEps()(opts); err != nil || !opts.AutoEps {
+		t.Errorf("WithAutoEps() error = %v, opts.AutoEps = %v, want nil, true", err, opts.AutoEps)
+	}
+	if err := WithEps(0.5)(opts); err != nil || opts.AutoEps {
+		t.Errorf("WithEps(0.5) error = %v, opts.AutoEps = %v, want nil, false", err, opts.AutoEps)
+	}
+}
+
+func TestWithVertexOverride(t *testing.T) {
+	opts := &DiagramOptions{}
+	if err := WithVertexOverride(nil)(opts); err == nil {
+		t.Errorf("WithVertexOverride(nil) error = nil, want non-nil")
+	}
+	fn := func([3]s2.Point, int) (s2.Point, bool) { return s2.Point{}, false }
+	if err := WithVertexOverride(fn)(opts); err != nil {
+		t.Errorf("WithVertexOverride(fn) error = %v, want nil", err)
+	}
+	if opts.VertexOverride == nil {
+		t.Errorf("WithVertexOverride(fn) opts.VertexOverride = nil, want non-nil")
+	}
+}
+
+func TestWithOverrideTolerance(t *testing.T) {
+	tests := []struct {
+		name    string
+		tol     s1.Angle
+		wantErr bool
+	}{
+		{"tol positive", 1e-6, false},
+		{"tol zero", 0, true},
+		{"tol negative", -1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &DiagramOptions{OverrideTolerance: defaultOverrideTolerance}
+			err := WithOverrideTolerance(tt.tol)(opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WithOverrideTolerance(%v) error = %v, wantErr %v", tt.tol, err, tt.wantErr)
+			}
+			if err == nil && opts.OverrideTolerance != tt.tol {
+				t.Errorf("WithOverrideTolerance(%v) opts.OverrideTolerance = %v, want %v", tt.tol,
+					opts.OverrideTolerance, tt.tol)
+			}
+		})
+	}
+}
+
 // Diagram
 
 func TestNewDiagram_WithEps(t *testing.T) {
@@ -101,6 +156,231 @@ func TestDiagram_Invariants(t *testing.T) {
 	}
 }
 
+func TestNewDiagram_Graticule(t *testing.T) {
+	points := utils.GenerateGraticulePoints(10, 15)
+	vd, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	if got, want := len(vd.Vertices), 2*len(points)-4; got != want {
+		t.Errorf("len(vd.Vertices) = %d, want %d", got, want)
+	}
+
+	total := 0.0
+	for i := range vd.NumCells() {
+		c, _ := vd.Cell(i)
+		total += c.Area()
+		if got := vd.CellContainingPoint(c.Site()).SiteIndex(); got != i {
+			t.Errorf("vd.CellContainingPoint(sites[%d]) = %d, want %d", i, got, i)
+		}
+	}
+	if math.Abs(total-4*math.Pi) > 1e-9 {
+		t.Errorf("total cell area = %v, want %v", total, 4*math.Pi)
+	}
+}
+
+func TestNewDiagram_WithAutoEps(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	near := s2.Point{Vector: points[0].Add(s2.Ortho(points[0]).Mul(1e-13)).Normalize()}
+	points = append(points, near)
+	if _, err := NewDiagram(points); err == nil {
+		t.Fatalf("NewDiagram(...) error = nil, want non-nil with default eps")
+	}
+	if _, err := NewDiagram(points, WithAutoEps()); err != nil {
+		t.Errorf("NewDiagram(..., WithAutoEps()) error = %v, want nil", err)
+	}
+}
+
+func TestNewDiagram_WithVertexOverride(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	want, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	calls := 0
+	exact := func(tri [3]s2.Point, tIdx int) (s2.Point, bool) {
+		calls++
+		if tIdx%2 == 0 {
+			return s2.Point{}, false
+		}
+		c, err := s2delaunay.Circumcenter(tri[0], tri[1], tri[2])
+		return c, err == nil
+	}
+	got, err := NewDiagram(points, WithVertexOverride(exact))
+	if err != nil {
+		t.Fatalf("NewDiagram(..., WithVertexOverride(exact)) error = %v, want nil", err)
+	}
+	if calls != len(want.Vertices) {
+		t.Errorf("override calls = %d, want %d", calls, len(want.Vertices))
+	}
+	if diff := cmp.Diff(want.Vertices, got.Vertices); diff != "" {
+		t.Errorf("NewDiagram(..., WithVertexOverride(exact)) Vertices mismatch (-want +got):\n%s",
+			diff)
+	}
+
+	tests := []struct {
+		name string
+		fn   VertexOverrideFunc
+		opts []DiagramOption
+	}{
+		{
+			"not unit",
+			func(tri [3]s2.Point, _ int) (s2.Point, bool) {
+				c, _ := s2delaunay.Circumcenter(tri[0], tri[1], tri[2])
+				return s2.Point{Vector: c.Mul(2)}, true
+			},
+			nil,
+		},
+		{
+			"not unit loose",
+			func(tri [3]s2.Point, _ int) (s2.Point, bool) {
+				c, _ := s2delaunay.Circumcenter(tri[0], tri[1], tri[2])
+				return s2.Point{Vector: c.Mul(2)}, true
+			},
+			[]DiagramOption{WithOverrideTolerance(math.Pi)},
+		},
+		{
+			"nan",
+			func(tri [3]s2.Point, _ int) (s2.Point, bool) {
+				return s2.Point{Vector: r3.Vector{X: math.NaN(), Y: 0, Z: 1}}, true
+			},
+			[]DiagramOption{WithOverrideTolerance(math.Pi)},
+		},
+		{
+			"not equidistant",
+			func(tri [3]s2.Point, _ int) (s2.Point, bool) { return tri[0], true },
+			nil,
+		},
+		{
+			"centroid strict",
+			func(tri [3]s2.Point, _ int) (s2.Point, bool) {
+				return s2.Point{Vector: s2.PlanarCentroid(tri[0], tri[1], tri[2]).Normalize()}, true
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]DiagramOption{WithVertexOverride(tt.fn)}, tt.opts...)
+			if _, err := NewDiagram(points, opts...); err == nil {
+				t.Errorf("NewDiagram(..., WithVertexOverride(%s)) error = nil, want non-nil", tt.name)
+			}
+		})
+	}
+
+	centroid := func(tri [3]s2.Point, _ int) (s2.Point, bool) {
+		return s2.Point{Vector: s2.PlanarCentroid(tri[0], tri[1], tri[2]).Normalize()}, true
+	}
+	if _, err := NewDiagram(points, WithVertexOverride(centroid),
+		WithOverrideTolerance(math.Pi)); err != nil {
+		t.Errorf("NewDiagram(..., WithVertexOverride(centroid), WithOverrideTolerance(π)) error = %v, "+
+			"want nil", err)
+	}
+}
+
+func TestNewDiagramFromTriangulation(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	want, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+
+	dt, err := s2delaunay.NewTriangulation(points)
+	if err != nil {
+		t.Fatalf("s2delaunay.NewTriangulation(...) error = %v, want nil", err)
+	}
+	vd, err := NewDiagramFromTriangulation(dt)
+	if err != nil {
+		t.Fatalf("NewDiagramFromTriangulation(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, vd, cmpopts.IgnoreUnexported(Diagram{})); diff != "" {
+		t.Errorf("NewDiagramFromTriangulation(...) mismatch (-want +got):\n%s", diff)
+	}
+
+	// Mutating the triangulation must not affect a diagram with its own storage.
+	dt.Vertices[0] = dt.Vertices[1]
+	dt.IncidentTriangleIndices[0] = -1
+	dt.IncidentTriangleOffsets[1] = -1
+	if diff := cmp.Diff(want, vd, cmpopts.IgnoreUnexported(Diagram{})); diff != "" {
+		t.Errorf("vd changed after mutating dt (-want +got):\n%s", diff)
+	}
+
+	partial := &s2delaunay.Triangulation{Partial: true}
+	if _, err := NewDiagramFromTriangulation(partial); !errors.Is(err, ErrDiagramConstruction) {
+		t.Errorf("NewDiagramFromTriangulation(partial) error = %v, want ErrDiagramConstruction",
+			err)
+	}
+}
+
+func TestWithSharedStorage(t *testing.T) {
+	dt, err := s2delaunay.NewTriangulation(utils.GenerateRandomPoints(100, 0))
+	if err != nil {
+		t.Fatalf("s2delaunay.NewTriangulation(...) error = %v, want nil", err)
+	}
+
+	shared, err := NewDiagramFromTriangulation(dt, WithSharedStorage())
+	if err != nil {
+		t.Fatalf("NewDiagramFromTriangulation(...) error = %v, want nil", err)
+	}
+	if &shared.Sites[0] != &dt.Vertices[0] ||
+		&shared.CellVertices[0] != &dt.IncidentTriangleIndices[0] ||
+		&shared.CellOffsets[0] != &dt.IncidentTriangleOffsets[0] {
+		t.Errorf("WithSharedStorage() diagram does not alias the triangulation")
+	}
+
+	copied, err := NewDiagramFromTriangulation(dt)
+	if err != nil {
+		t.Fatalf("NewDiagramFromTriangulation(...) error = %v, want nil", err)
+	}
+	if &copied.Sites[0] == &dt.Vertices[0] ||
+		&copied.CellVertices[0] == &dt.IncidentTriangleIndices[0] ||
+		&copied.CellOffsets[0] == &dt.IncidentTriangleOffsets[0] {
+		t.Errorf("default diagram aliases the triangulation")
+	}
+
+	points := utils.GenerateRandomPoints(100, 1)
+	vd, err := NewDiagram(points, WithSharedStorage())
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	if &vd.Sites[0] == &points[0] {
+		t.Errorf("WithSharedStorage() diagram aliases the input sites")
+	}
+}
+
+func TestNewBarycentricDualDiagram(t *testing.T) {
+	points := utils.GenerateRandomPoints(100, 0)
+	vd, err := NewBarycentricDualDiagram(points)
+	if err != nil {
+		t.Fatalf("NewBarycentricDualDiagram(...) error = %v, want nil", err)
+	}
+	if vd.Dual != BarycentricDual {
+		t.Errorf("vd.Dual = %v, want %v", vd.Dual, BarycentricDual)
+	}
+
+	dt, err := s2delaunay.NewTriangulation(points)
+	if err != nil {
+		t.Fatalf("s2delaunay.NewTriangulation(...) error = %v, want nil", err)
+	}
+	for i, v := range vd.Vertices {
+		if math.Abs(v.Norm()-1) > defaultEps {
+			t.Errorf("vd.Vertices[%d] norm = %v, want ~1.0", i, v.Norm())
+		}
+		p, err := dt.TriangleVertices(i)
+		if err != nil {
+			t.Fatalf("dt.TriangleVertices(%d) error = %v, want nil", i, err)
+		}
+		if !s2.Sign(p[0], p[1], v) || !s2.Sign(p[1], p[2], v) || !s2.Sign(p[2], p[0], v) {
+			t.Errorf("vd.Vertices[%d] = %v not inside its triangle", i, v)
+		}
+	}
+
+	if got := mustNewDiagram(t, 100).Dual; got != CircumcentricDual {
+		t.Errorf("NewDiagram(...) Dual = %v, want %v", got, CircumcentricDual)
+	}
+}
+
 func TestNewTriangulation_DegenerateInput(t *testing.T) {
 	// TODO: Add more tests for broken or invalid scenarios.
 	points := utils.GenerateRandomPoints(3, 0)
@@ -211,42 +491,125 @@ func TestDiagram_Cell(t *testing.T) {
 			}
 
 			want := Cell{tt.index, vd}
-			if diff := cmp.Diff(want, c, cmp.AllowUnexported(Cell{})); err == nil && diff != "" {
+			if diff := cmp.Diff(want, c, cmp.AllowUnexported(Cell{}),
+				cmpopts.IgnoreUnexported(Diagram{})); err == nil && diff != "" {
 				t.Errorf("Diagram.Cell(%d) mismatch (-want +got):\n%s", tt.index, diff)
 			}
 		})
 	}
 }
 
-func TestTriangleCircumcenter(t *testing.T) {
-	tests := []struct {
-		name       string
-		p0, p1, p2 s2.Point
-		want       s2.Point
-	}{
-		{
-			"xyz orthonormal",
-			s2.PointFromCoords(1, 0, 0),
-			s2.PointFromCoords(0, 1, 0),
-			s2.PointFromCoords(0, 0, 1),
-			s2.PointFromCoords(1, 1, 1),
-		},
-		{
-			"xyz orthonormal reversed",
-			s2.PointFromCoords(0, 0, 1),
-			s2.PointFromCoords(0, 1, 0),
-			s2.PointFromCoords(1, 0, 0),
-			s2.PointFromCoords(1, 1, 1),
-		},
+func TestDiagram_CellTriangles(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	dt, err := s2delaunay.NewTriangulation(vd.Sites)
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
 	}
+	for i := range vd.NumCells() {
+		tris := vd.CellTriangles(i)
+		neighbors := Cell{idx: i, d: vd}.NeighborIndices()
+		for j, tIdx := range tris {
+			prev := neighbors[(j+len(neighbors)-1)%len(neighbors)]
+			want := s2delaunay.Triangle{i, neighbors[j], prev}
+			if !sameTriangle(dt.Triangles[tIdx], want) {
+				t.Errorf("cell %d: triangle %d = %v, want %v", i, j, dt.Triangles[tIdx], want)
+			}
+			c, _ := dt.Circumcenter(tIdx)
+			if v, _ := (Cell{idx: i, d: vd}).Vertex(j); !v.ApproxEqual(c) {
+				t.Errorf("cell %d: vertex %d = %v, want circumcenter %v", i, j, v, c)
+			}
+		}
+	}
+	if got := vd.CellTriangles(vd.NumCells()); got != nil {
+		t.Errorf("vd.CellTriangles(out of range) = %v, want nil", got)
+	}
+	tris := vd.CellTriangles(0)
+	tris[0] = -1
+	if vd.CellVertices[0] == -1 {
+		t.Errorf("vd.CellTriangles(0) aliases CellVertices")
+	}
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := triangleCircumcenter(tt.p0, tt.p1, tt.p2)
-			if got.Distance(tt.want) > 1e-9 {
-				t.Errorf("triangleCircumcenter(...) = %v, want %v", got, tt.want)
+func TestDiagram_CellTriangles_Decoded(t *testing.T) {
+	// Cocircular sites of a graticule give coincident vertices, which stay distinct triangles,
+	// and decoding renumbers the vertices into canonical order.
+	vd, err := NewDiagram(utils.GenerateGraticulePoints(10, 15))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	data, err := vd.MarshalText()
+	if err != nil {
+		t.Fatalf("vd.MarshalText() error = %v, want nil", err)
+	}
+	var decoded Diagram
+	if err := decoded.UnmarshalText(data); err != nil {
+		t.Fatalf("decoded.UnmarshalText(...) error = %v, want nil", err)
+	}
+
+	for _, d := range []*Diagram{vd, &decoded} {
+		// The triangle of vertex t is formed by the sites of the cells listing it.
+		cells := make([][]int, len(d.Vertices))
+		for i := range d.NumCells() {
+			for j, tIdx := range d.CellTriangles(i) {
+				if tIdx != d.CellVertices[d.CellOffsets[i]+j] {
+					t.Fatalf("cell %d: triangle %d = %d, want ring vertex", i, j, tIdx)
+				}
+				cells[tIdx] = append(cells[tIdx], i)
 			}
-		})
+		}
+		for tIdx, c := range cells {
+			if len(c) != 3 {
+				t.Fatalf("triangle %d is listed by cells %v, want 3", tIdx, c)
+			}
+			cc, _ := s2delaunay.Circumcenter(d.Sites[c[0]], d.Sites[c[1]], d.Sites[c[2]])
+			if !d.Vertices[tIdx].ApproxEqual(cc) {
+				t.Errorf("vertex %d = %v, want circumcenter %v", tIdx, d.Vertices[tIdx], cc)
+			}
+		}
+	}
+}
+
+// sameTriangle reports whether a and b list the same vertices in the same cyclic order.
+func sameTriangle(a, b s2delaunay.Triangle) bool {
+	for range 3 {
+		if a == b {
+			return true
+		}
+		a = s2delaunay.Triangle{a[1], a[2], a[0]}
+	}
+	return false
+}
+
+func TestDiagram_Rebuild(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	vertices := &vd.Vertices[0]
+	neighbors := &vd.CellNeighbors[0]
+
+	points := utils.GenerateRandomPoints(100, 1)
+	if err := vd.Rebuild(points); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	if &vd.Vertices[0] != vertices || &vd.CellNeighbors[0] != neighbors {
+		t.Errorf("vd.Rebuild(...) reallocated storage for equal site count")
+	}
+
+	want, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, vd, cmpopts.IgnoreUnexported(Diagram{})); diff != "" {
+		t.Errorf("vd.Rebuild(...) mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := vd.Rebuild(utils.GenerateRandomPoints(200, 2)); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	if got, want := len(vd.Vertices), 2*200-4; got != want {
+		t.Errorf("vd.Vertices count = %v, want %v", got, want)
+	}
+
+	if err := vd.Rebuild(utils.GenerateRandomPoints(3, 0)); err == nil {
+		t.Errorf("vd.Rebuild(...) error = nil, want non-nil")
 	}
 }
 
@@ -270,6 +633,27 @@ func BenchmarkNewDiagram(b *testing.B) {
 	}
 }
 
+func BenchmarkDiagram_Rebuild(b *testing.B) {
+	sizes := []int{1e+2, 1e+3, 1e+4, 1e+5}
+	for _, pointsCnt := range sizes {
+		b.Run(fmt.Sprintf("N%d", pointsCnt), func(b *testing.B) {
+			points := utils.GenerateRandomPoints(pointsCnt, 0)
+			vd, err := NewDiagram(points)
+			if err != nil {
+				b.Fatalf("NewDiagram(...) error = %v, want nil", err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for b.Loop() {
+				if err := vd.Rebuild(points); err != nil {
+					b.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+				}
+			}
+		})
+	}
+}
+
 // Helpers
 
 func mustNewDiagram(t *testing.T, n int) *Diagram {
@@ -293,3 +677,28 @@ func computeAngleCCW(refVec, vec, normal s2.Point) float64 {
 	}
 	return angle
 }
+
+func nearestSiteBruteForce(vd *Diagram, p s2.Point) int {
+	best := 0
+	for i, s := range vd.Sites {
+		if p.Dot(s.Vector) > p.Dot(vd.Sites[best].Vector) {
+			best = i
+		}
+	}
+	return best
+}
+
+func ringArea(ring []s2.Point) float64 {
+	n := len(ring)
+	reversed := make([]s2.Point, n)
+	for i, p := range ring {
+		reversed[n-1-i] = p
+	}
+	return s2.LoopFromPoints(reversed).Area()
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return sorted[len(sorted)/2]
+}
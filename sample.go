@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/golang/geo/s2"
+)
+
+// SamplePoints scatters totalPoints random points over the sphere, distributing them among the
+// cells in proportion to their areas and uniformly within each cell. It returns the sampled
+// points together with the index of the cell owning each point.
+// The seed parameter ensures reproducibility.
+func (d *Diagram) SamplePoints(totalPoints int, seed int64) (s2.PointVector, []int) {
+	if totalPoints <= 0 {
+		return s2.PointVector{}, []int{}
+	}
+
+	areas := d.CellAreas()
+	totalArea := 0.0
+	for _, a := range areas {
+		totalArea += a
+	}
+	counts := apportion(areas, totalArea, totalPoints)
+
+	//nolint:gosec
+	random := rand.New(rand.NewSource(seed))
+	points := make(s2.PointVector, 0, totalPoints)
+	owners := make([]int, 0, totalPoints)
+	for i, cnt := range counts {
+		if cnt == 0 {
+			continue
+		}
+		loop := Cell{idx: i, d: d}.Loop()
+		bound := loop.CapBound()
+		for range cnt {
+			p := sampleCap(random, bound)
+			for !loop.ContainsPoint(p) {
+				p = sampleCap(random, bound)
+			}
+			points = append(points, p)
+			owners = append(owners, i)
+		}
+	}
+
+	return points, owners
+}
+
+// apportion splits total into integer counts proportional to weights using the largest
+// remainder method. Ties are broken by ascending index.
+func apportion(weights []float64, sum float64, total int) []int {
+	counts := make([]int, len(weights))
+	if sum <= 0 {
+		return counts
+	}
+
+	remainders := make([]float64, len(weights))
+	assigned := 0
+	for i, w := range weights {
+		exact := w / sum * float64(total)
+		counts[i] = int(math.Floor(exact))
+		remainders[i] = exact - float64(counts[i])
+		assigned += counts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; assigned < total; i++ {
+		counts[order[i%len(order)]]++
+		assigned++
+	}
+
+	return counts
+}
+
+// sampleCap returns a point uniformly distributed within the cap.
+func sampleCap(random *rand.Rand, c s2.Cap) s2.Point {
+	axis := c.Center()
+	u := s2.Ortho(axis)
+	v := axis.Cross(u.Vector)
+
+	z := 1 - random.Float64()*min(c.Height(), 2)
+	r := math.Sqrt(max(0, 1-z*z))
+	phi := random.Float64() * 2 * math.Pi
+
+	vec := axis.Mul(z).
+		Add(u.Mul(r * math.Cos(phi))).
+		Add(v.Mul(r * math.Sin(phi)))
+	return s2.Point{Vector: vec.Normalize()}
+}
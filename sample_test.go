@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Sampling
+
+func TestDiagram_SamplePoints(t *testing.T) {
+	vd := mustNewDiagram(t, 50)
+	const total = 2000
+	points, owners := vd.SamplePoints(total, 0)
+	if len(points) != total || len(owners) != total {
+		t.Fatalf("vd.SamplePoints(%d, 0) len = %d, %d, want %d", total, len(points),
+			len(owners), total)
+	}
+
+	counts := make([]int, vd.NumCells())
+	for i, p := range points {
+		if math.Abs(p.Norm()-1) > defaultEps {
+			t.Errorf("points[%d] norm = %v, want ~1.0", i, p.Norm())
+		}
+		if want := nearestSiteBruteForce(vd, p); owners[i] != want {
+			t.Errorf("owners[%d] = %d, want %d", i, owners[i], want)
+		}
+		counts[owners[i]]++
+	}
+
+	for i, cnt := range counts {
+		c, err := vd.Cell(i)
+		if err != nil {
+			t.Fatalf("vd.Cell(%d) error = %v, want nil", i, err)
+		}
+		want := c.Loop().Area() / (4 * math.Pi) * total
+		if math.Abs(float64(cnt)-want) > 1 {
+			t.Errorf("cell %d sample count = %d, want %v", i, cnt, want)
+		}
+	}
+}
+
+func TestDiagram_SamplePoints_Determinism(t *testing.T) {
+	vd := mustNewDiagram(t, 20)
+	a, ao := vd.SamplePoints(100, 7)
+	b, bo := vd.SamplePoints(100, 7)
+	if diff := cmp.Diff(a, b); diff != "" {
+		t.Errorf("vd.SamplePoints(100, 7) points mismatch (-first +second):\n%s", diff)
+	}
+	if diff := cmp.Diff(ao, bo); diff != "" {
+		t.Errorf("vd.SamplePoints(100, 7) owners mismatch (-first +second):\n%s", diff)
+	}
+
+	if p, o := vd.SamplePoints(0, 0); len(p) != 0 || len(o) != 0 {
+		t.Errorf("vd.SamplePoints(0, 0) len = %d, %d, want 0, 0", len(p), len(o))
+	}
+}
+
+func TestApportion(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float64
+		total   int
+		want    []int
+	}{
+		{"even", []float64{1, 1, 1, 1}, 8, []int{2, 2, 2, 2}},
+		{"remainder ties", []float64{1, 1, 1}, 4, []int{2, 1, 1}},
+		{"largest remainder", []float64{0.6, 0.3, 0.1}, 3, []int{2, 1, 0}},
+		{"zero weights", []float64{0, 0}, 5, []int{0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := 0.0
+			for _, w := range tt.weights {
+				sum += w
+			}
+			got := apportion(tt.weights, sum, tt.total)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("apportion(%v, %v, %d) mismatch (-want +got):\n%s", tt.weights, sum,
+					tt.total, diff)
+			}
+		})
+	}
+}
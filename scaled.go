@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
+)
+
+// ScaledDiagram is a view of a Diagram on a sphere of radius r, such as a planet radius in
+// kilometers. Its accessors return coordinates and lengths multiplied by r and areas by r²,
+// so callers do not convert radians and steradians themselves. It shares the arrays of the
+// diagram and reflects later changes to it.
+type ScaledDiagram struct {
+	d *Diagram
+	r float64
+}
+
+// ScaledIterationReport is an IterationReport with the displacement in units of the radius.
+type ScaledIterationReport struct {
+	// MaxDisplacement is the largest great-circle distance a site moved.
+	MaxDisplacement float64
+	// CellsWithChangedNeighbors is the number of cells whose cyclic neighbor ring changed.
+	CellsWithChangedNeighbors int
+	// Converged reports whether MaxDisplacement is within the requested tolerance.
+	Converged bool
+}
+
+// WithRadius returns a view of the diagram on a sphere of radius r, for example r = 6371 for
+// kilometers on the Earth. The radius is used as given, so it should be positive.
+func (d *Diagram) WithRadius(r float64) ScaledDiagram {
+	return ScaledDiagram{d: d, r: r}
+}
+
+// Diagram returns the underlying unit-sphere diagram.
+func (s ScaledDiagram) Diagram() *Diagram {
+	return s.d
+}
+
+// Radius returns the radius of the sphere.
+func (s ScaledDiagram) Radius() float64 {
+	return s.r
+}
+
+// NumCells returns the number of cells in the diagram.
+func (s ScaledDiagram) NumCells() int {
+	return s.d.NumCells()
+}
+
+// Site returns the coordinates of site i scaled by the radius.
+// It returns an error if the index is out of range.
+func (s ScaledDiagram) Site(i int) (r3.Vector, error) {
+	if i < 0 || i >= len(s.d.Sites) {
+		return r3.Vector{}, fmt.Errorf("Site: index %d %w [0 %d)", i, ErrOutOfRange,
+			len(s.d.Sites))
+	}
+	return s.d.Sites[i].Mul(s.r), nil
+}
+
+// Vertex returns the coordinates of Voronoi vertex i scaled by the radius.
+// It returns an error if the index is out of range.
+func (s ScaledDiagram) Vertex(i int) (r3.Vector, error) {
+	if i < 0 || i >= len(s.d.Vertices) {
+		return r3.Vector{}, fmt.Errorf("Vertex: index %d %w [0 %d)", i, ErrOutOfRange,
+			len(s.d.Vertices))
+	}
+	return s.d.Vertices[i].Mul(s.r), nil
+}
+
+// Cell returns the scaled view of cell i.
+// It returns an error if the index is out of range.
+func (s ScaledDiagram) Cell(i int) (ScaledCell, error) {
+	c, err := s.d.Cell(i)
+	if err != nil {
+		return ScaledCell{}, err
+	}
+	return ScaledCell{c: c, r: s.r}, nil
+}
+
+// CellAreas returns the area of every cell, indexed like Sites, summing to 4πr².
+func (s ScaledDiagram) CellAreas() []float64 {
+	areas := s.d.CellAreas()
+	for i := range areas {
+		areas[i] *= s.r * s.r
+	}
+	return areas
+}
+
+// MaxRadius returns the truncation radius of the cells as a great-circle distance, or 0 if
+// they are not truncated.
+func (s ScaledDiagram) MaxRadius() float64 {
+	return s.d.MaxRadius().Radians() * s.r
+}
+
+// UnclaimedArea returns the area outside every truncated cell, or 0 if the cells are not
+// truncated.
+func (s ScaledDiagram) UnclaimedArea() float64 {
+	return s.d.UnclaimedArea() * (s.r * s.r)
+}
+
+// Overlay returns the pairs of Overlay with the diagram as a, with each Area scaled by r².
+func (s ScaledDiagram) Overlay(b *Diagram) []OverlayPair {
+	pairs := Overlay(s.d, b)
+	for i := range pairs {
+		pairs[i].Area *= s.r * s.r
+	}
+	return pairs
+}
+
+// CompareIterations reports the changes from the diagram to next like the package-level
+// CompareIterations, with the displacement and tol as great-circle distances.
+// It returns an error if the diagrams have different numbers of cells.
+func (s ScaledDiagram) CompareIterations(next *Diagram, tol float64) (ScaledIterationReport,
+	error) {
+	r, err := CompareIterations(s.d, next, s1.Angle(tol/s.r))
+	if err != nil {
+		return ScaledIterationReport{}, err
+	}
+	displacement := r.MaxDisplacement.Radians() * s.r
+	return ScaledIterationReport{
+		MaxDisplacement:           displacement,
+		CellsWithChangedNeighbors: r.CellsWithChangedNeighbors,
+		Converged:                 displacement <= tol,
+	}, nil
+}
+
+// ScaledCell is a view of a Cell on the sphere of its ScaledDiagram.
+type ScaledCell struct {
+	c Cell
+	r float64
+}
+
+// Cell returns the underlying unit-sphere cell.
+func (c ScaledCell) Cell() Cell {
+	return c.c
+}
+
+// Site returns the coordinates of the site scaled by the radius.
+func (c ScaledCell) Site() r3.Vector {
+	return c.c.Site().Mul(c.r)
+}
+
+// Area returns the area of the cell.
+func (c ScaledCell) Area() float64 {
+	return c.c.Area() * (c.r * c.r)
+}
+
+// ClaimedArea returns the area of the cell intersected with the truncation cap around its
+// site, as Cell.ClaimedArea.
+func (c ScaledCell) ClaimedArea() float64 {
+	return c.c.ClaimedArea() * (c.r * c.r)
+}
+
+// Perimeter returns the length of the cell boundary.
+func (c ScaledCell) Perimeter() float64 {
+	return c.c.Perimeter().Radians() * c.r
+}
+
+// NeighborDistances returns the great-circle distance from the site to the site of each
+// neighbor, in NeighborIndices order.
+func (c ScaledCell) NeighborDistances() []float64 {
+	angles := c.c.NeighborDistances()
+	distances := make([]float64, len(angles))
+	for i, a := range angles {
+		distances[i] = a.Radians() * c.r
+	}
+	return distances
+}
+
+// ExtentToward returns the great-circle distance from the site to the cell boundary in
+// direction dir, as Cell.ExtentToward.
+// It returns an error if dir has no tangent component.
+func (c ScaledCell) ExtentToward(dir r3.Vector) (float64, error) {
+	a, err := c.c.ExtentToward(dir)
+	if err != nil {
+		return 0, err
+	}
+	return a.Radians() * c.r, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+)
+
+// Scaled
+
+func TestDiagram_WithRadius(t *testing.T) {
+	const r = 6371.0
+	vd := mustNewDiagram(t, 100)
+	sd := vd.WithRadius(r)
+	if sd.Diagram() != vd || sd.Radius() != r || sd.NumCells() != vd.NumCells() {
+		t.Fatalf("vd.WithRadius(%v) = %+v, want a view of vd", r, sd)
+	}
+
+	total := 0.0
+	for i, a := range sd.CellAreas() {
+		c := Cell{idx: i, d: vd}
+		sc, err := sd.Cell(i)
+		if err != nil {
+			t.Fatalf("sd.Cell(%d) error = %v, want nil", i, err)
+		}
+		if want := c.Area() * (r * r); a != want || sc.Area() != want {
+			t.Errorf("cell %d: scaled area = %v and %v, want %v", i, a, sc.Area(), want)
+		}
+		if got, want := sc.Perimeter(), c.Perimeter().Radians()*r; got != want {
+			t.Errorf("cell %d: sc.Perimeter() = %v, want %v", i, got, want)
+		}
+		for k, d := range sc.NeighborDistances() {
+			if want := c.NeighborDistances()[k].Radians() * r; d != want {
+				t.Errorf("cell %d: sc.NeighborDistances()[%d] = %v, want %v", i, k, d, want)
+			}
+		}
+		if got, want := sc.Site(), c.Site().Mul(r); got != want {
+			t.Errorf("cell %d: sc.Site() = %v, want %v", i, got, want)
+		}
+		dir := r3.Vector{X: 1, Y: 2, Z: 3}
+		unit, _ := c.ExtentToward(dir)
+		if got, _ := sc.ExtentToward(dir); got != unit.Radians()*r {
+			t.Errorf("cell %d: sc.ExtentToward(...) = %v, want %v", i, got, unit.Radians()*r)
+		}
+		total += a
+	}
+	if want := 4 * math.Pi * r * r; math.Abs(total-want) > 1e-9*want {
+		t.Errorf("sum of sd.CellAreas() = %v, want %v", total, want)
+	}
+
+	if got, err := sd.Vertex(3); err != nil || got != vd.Vertices[3].Mul(r) {
+		t.Errorf("sd.Vertex(3) = %v, %v, want %v, nil", got, err, vd.Vertices[3].Mul(r))
+	}
+	if _, err := sd.Site(-1); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("sd.Site(-1) error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := sd.Cell(vd.NumCells()); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("sd.Cell(%d) error = %v, want ErrOutOfRange", vd.NumCells(), err)
+	}
+}
+
+func TestScaledDiagram_Reports(t *testing.T) {
+	const r = 2.5
+	a := mustNewDiagram(t, 50)
+	b, err := NewDiagram(utils.GenerateRandomPoints(50, 1))
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	sd := a.WithRadius(r)
+
+	unitPairs := Overlay(a, b)
+	for i, p := range sd.Overlay(b) {
+		if want := unitPairs[i].Area * (r * r); p.Area != want {
+			t.Errorf("sd.Overlay(b)[%d].Area = %v, want %v", i, p.Area, want)
+		}
+	}
+
+	unit, err := CompareIterations(a, b, 0.1)
+	if err != nil {
+		t.Fatalf("CompareIterations(...) error = %v, want nil", err)
+	}
+	got, err := sd.CompareIterations(b, 0.1*r)
+	if err != nil {
+		t.Fatalf("sd.CompareIterations(...) error = %v, want nil", err)
+	}
+	if want := unit.MaxDisplacement.Radians() * r; got.MaxDisplacement != want {
+		t.Errorf("MaxDisplacement = %v, want %v", got.MaxDisplacement, want)
+	}
+	if got.CellsWithChangedNeighbors != unit.CellsWithChangedNeighbors ||
+		got.Converged != unit.Converged {
+		t.Errorf("sd.CompareIterations(...) = %+v, want counts of %+v", got, unit)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Shard partitions the cells into numShards connected groups of roughly equal total area and
+// returns the cell indices of each group in ascending order. Every cell belongs to exactly one
+// shard, and the result is deterministic.
+// Seeds are spread over the neighbor graph by farthest-point selection in hops, then the shard
+// with the smallest area repeatedly claims the next unassigned cell on its breadth-first
+// frontier. A shard whose frontier is exhausted stops growing, so the balance is not exact;
+// ShardImbalance measures it.
+// A numShards larger than the number of cells is reduced to it, and a non-positive numShards
+// or a diagram built WithoutNeighbors returns nil.
+func (d *Diagram) Shard(numShards int) [][]int {
+	numShards = min(numShards, d.NumCells())
+	if numShards <= 0 || !d.hasNeighbors() {
+		return nil
+	}
+	return groupByOwner(d.growShards(numShards, d.CellAreas()), numShards)
+}
+
+// growShards partitions the cells into numShards connected groups of roughly equal total
+// weight as described by Shard, where numShards is in [1, NumCells], and returns the group of
+// each cell.
+func (d *Diagram) growShards(numShards int, weights []float64) []int {
+	n := d.NumCells()
+	owner := make([]int, n)
+	for i := range owner {
+		owner[i] = -1
+	}
+	q := make(shardQueue, numShards)
+	frontiers := make([][]int, numShards)
+	for s, seed := range d.shardSeeds(numShards, weights) {
+		owner[seed] = s
+		q[s] = shardEntry{shard: s, weight: weights[seed]}
+		frontiers[s] = slices.Clone((Cell{idx: seed, d: d}).NeighborIndices())
+	}
+	heap.Init(&q)
+
+	for q.Len() > 0 {
+		e := heap.Pop(&q).(shardEntry)
+		front := frontiers[e.shard]
+		for len(front) > 0 && owner[front[0]] >= 0 {
+			front = front[1:]
+		}
+		if len(front) == 0 {
+			frontiers[e.shard] = nil
+			continue
+		}
+		c := front[0]
+		owner[c] = e.shard
+		frontiers[e.shard] = append(front[1:], (Cell{idx: c, d: d}).NeighborIndices()...)
+		e.weight += weights[c]
+		heap.Push(&q, e)
+	}
+
+	return owner
+}
+
+// groupByOwner returns the cells of each of the k groups in ascending order.
+func groupByOwner(owner []int, k int) [][]int {
+	groups := make([][]int, k)
+	for i, g := range owner {
+		groups[g] = append(groups[g], i)
+	}
+	return groups
+}
+
+// ShardImbalance returns the ratio of the largest total shard area to the mean total shard
+// area, which is 1 for perfectly balanced shards. It returns 0 for no shards.
+func (d *Diagram) ShardImbalance(shards [][]int) float64 {
+	return imbalance(shards, d.CellAreas())
+}
+
+// imbalance returns the ratio of the largest total shard weight to the mean total shard
+// weight, or 0 if there are no shards or the total weight is zero.
+func imbalance(shards [][]int, weights []float64) float64 {
+	if len(shards) == 0 {
+		return 0
+	}
+	total, largest := 0.0, 0.0
+	for _, shard := range shards {
+		w := 0.0
+		for _, i := range shard {
+			w += weights[i]
+		}
+		total += w
+		largest = max(largest, w)
+	}
+	if total == 0 {
+		return 0
+	}
+	return largest * float64(len(shards)) / total
+}
+
+// shardSeeds returns k distinct cells spread over the neighbor graph: the first candidate, then
+// repeatedly the candidate with the most hops to the seeds chosen so far, ties going to the
+// lowest index. The candidates are the cells of positive weight if there are at least k of
+// them, and all cells otherwise.
+func (d *Diagram) shardSeeds(k int, weights []float64) []int {
+	n := d.NumCells()
+	var candidates []int
+	for i, w := range weights {
+		if w > 0 {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) < k {
+		candidates = make([]int, n)
+		for i := range candidates {
+			candidates[i] = i
+		}
+	}
+	hops := make([]int, n)
+	for i := range hops {
+		hops[i] = n
+	}
+	seeds := make([]int, 0, k)
+	next := candidates[0]
+	for len(seeds) < k {
+		seeds = append(seeds, next)
+		hops[next] = 0
+		queue := []int{next}
+		for len(queue) > 0 {
+			c := queue[0]
+			queue = queue[1:]
+			for _, nb := range (Cell{idx: c, d: d}).NeighborIndices() {
+				if hops[nb] > hops[c]+1 {
+					hops[nb] = hops[c] + 1
+					queue = append(queue, nb)
+				}
+			}
+		}
+		for _, c := range candidates {
+			if hops[c] > hops[next] {
+				next = c
+			}
+		}
+	}
+	return seeds
+}
+
+// shardEntry is a growing shard keyed by its current total weight.
+type shardEntry struct {
+	shard  int
+	weight float64
+}
+
+// shardQueue is a min-heap of shards by weight, ties going to the lower shard index.
+type shardQueue []shardEntry
+
+func (q shardQueue) Len() int { return len(q) }
+func (q shardQueue) Less(i, j int) bool {
+	if q[i].weight != q[j].weight {
+		return q[i].weight < q[j].weight
+	}
+	return q[i].shard < q[j].shard
+}
+func (q shardQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *shardQueue) Push(x any)   { *q = append(*q, x.(shardEntry)) }
+func (q *shardQueue) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
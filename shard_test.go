@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Shard
+
+func TestDiagram_Shard(t *testing.T) {
+	tests := []struct {
+		cells, shards int
+	}{
+		{100, 1},
+		{100, 7},
+		{1000, 4},
+		{1000, 16},
+		{5000, 32},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("N%d_S%d", tt.cells, tt.shards), func(t *testing.T) {
+			vd, err := NewDiagram(utils.GenerateRandomPoints(tt.cells, int64(tt.shards)))
+			if err != nil {
+				t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+			}
+			shards := vd.Shard(tt.shards)
+			if len(shards) != tt.shards {
+				t.Fatalf("len(vd.Shard(%d)) = %d, want %d", tt.shards, len(shards), tt.shards)
+			}
+			if diff := cmp.Diff(shards, vd.Shard(tt.shards)); diff != "" {
+				t.Errorf("vd.Shard(%d) is not deterministic (-first +second):\n%s", tt.shards, diff)
+			}
+
+			owner := make([]int, vd.NumCells())
+			for i := range owner {
+				owner[i] = -1
+			}
+			for s, shard := range shards {
+				for _, c := range shard {
+					if owner[c] >= 0 {
+						t.Fatalf("cell %d assigned to shards %d and %d", c, owner[c], s)
+					}
+					owner[c] = s
+				}
+			}
+			if i := slices.Index(owner, -1); i >= 0 {
+				t.Fatalf("cell %d not assigned to any shard", i)
+			}
+			for s, shard := range shards {
+				if !shardConnected(vd, shard, owner, s) {
+					t.Errorf("shard %d is not connected", s)
+				}
+			}
+
+			if got := vd.ShardImbalance(shards); got < 1 || got > 1.25 {
+				t.Errorf("vd.ShardImbalance(...) = %v, want in [1 1.25]", got)
+			}
+		})
+	}
+}
+
+func TestDiagram_Shard_Bounds(t *testing.T) {
+	vd := mustNewDiagram(t, 10)
+	if got := vd.Shard(0); got != nil {
+		t.Errorf("vd.Shard(0) = %v, want nil", got)
+	}
+	if got := len(vd.Shard(20)); got != 10 {
+		t.Errorf("len(vd.Shard(20)) = %d, want 10", got)
+	}
+	if got := vd.ShardImbalance(nil); got != 0 {
+		t.Errorf("vd.ShardImbalance(nil) = %v, want 0", got)
+	}
+}
+
+// shardConnected reports whether the cells of shard s form a connected subgraph.
+func shardConnected(vd *Diagram, shard, owner []int, s int) bool {
+	seen := map[int]bool{shard[0]: true}
+	queue := []int{shard[0]}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		for _, nb := range (Cell{idx: c, d: vd}).NeighborIndices() {
+			if owner[nb] == s && !seen[nb] {
+				seen[nb] = true
+				queue = append(queue, nb)
+			}
+		}
+	}
+	return len(seen) == len(shard)
+}
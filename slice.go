@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"slices"
+
+	"github.com/golang/geo/r2"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	// sliceAngleEps is the tolerance under which two transition angles along a great circle
+	// are treated as passing through the same Voronoi vertex.
+	sliceAngleEps = 1e-12
+)
+
+// CellsOnGreatCircle returns the cells whose interior intersects the great circle with the
+// given normal, in the order they are traversed counterclockwise around the normal. The walk
+// starts from the cell containing s2.Ortho(normal) and ends when it returns to that cell.
+// Cells the circle only touches at a Voronoi vertex are not included.
+// It returns nil if the diagram was built WithoutNeighbors.
+func (d *Diagram) CellsOnGreatCircle(normal s2.Point) []int {
+	if !d.hasNeighbors() {
+		return nil
+	}
+	n := s2.Point{Vector: normal.Normalize()}
+	u := s2.Ortho(n)
+	w := n.Cross(u.Vector)
+
+	// Along the circle p(θ) = u cos θ + w sin θ, cell i wins where its projected site q_i
+	// maximizes q_i·(cos θ, sin θ), so consecutive cells are found by gift wrapping the
+	// projected sites, restricting candidates to the neighbor graph.
+	project := func(i int) r2.Point {
+		return r2.Point{X: d.Sites[i].Dot(u.Vector), Y: d.Sites[i].Dot(w)}
+	}
+
+	start := d.locate(u, 0)
+	cells := []int{start}
+	cur, theta := start, 0.0
+	for range d.NumCells() {
+		next, nextTheta := d.nextOnCircle(cur, theta, project)
+		if next < 0 || next == start {
+			break
+		}
+		cells = append(cells, next)
+		cur, theta = next, nextTheta
+	}
+	return cells
+}
+
+// nextOnCircle returns the cell following cur along the circle after angle theta, and the
+// angle at which the circle enters it, or -1 if no candidate exists.
+func (d *Diagram) nextOnCircle(cur int, theta float64, project func(int) r2.Point) (int,
+	float64) {
+	qc := project(cur)
+	transition := func(j int) (float64, float64) {
+		delta := project(j).Sub(qc)
+		a := math.Atan2(-delta.X, delta.Y)
+		return math.Mod(a-theta+4*math.Pi, 2*math.Pi), delta.Norm()
+	}
+
+	best, bestDiff, bestDist := -1, 0.0, 0.0
+	frontier := Cell{idx: cur, d: d}.NeighborIndices()
+	seen := []int{cur}
+	for len(frontier) > 0 {
+		var tied []int
+		for _, j := range frontier {
+			if slices.Contains(seen, j) {
+				continue
+			}
+			seen = append(seen, j)
+			diff, dist := transition(j)
+			switch {
+			case best < 0 || diff < bestDiff-sliceAngleEps:
+				best, bestDiff, bestDist = j, diff, dist
+				tied = tied[:0]
+			case math.Abs(diff-bestDiff) <= sliceAngleEps:
+				// Sites collinear in projection meet the circle at one Voronoi vertex; only the
+				// farthest one continues past it, and it need not be adjacent to cur.
+				tied = append(tied, j)
+				if dist > bestDist {
+					best, bestDiff, bestDist = j, diff, dist
+				}
+			}
+		}
+		frontier = nil
+		if len(tied) > 0 {
+			tied = append(tied, best)
+			for _, j := range tied {
+				frontier = append(frontier, Cell{idx: j, d: d}.NeighborIndices()...)
+			}
+		}
+	}
+	if best < 0 {
+		return -1, 0
+	}
+	return best, math.Mod(theta+bestDiff, 2*math.Pi)
+}
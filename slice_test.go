@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r2"
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Slice
+
+func TestDiagram_CellsOnGreatCircle(t *testing.T) {
+	vd := mustNewDiagram(t, 1000)
+	for i, n := range utils.GenerateRandomPoints(20, 1) {
+		got := vd.CellsOnGreatCircle(n)
+		want := cellsOnGreatCircleBruteForce(vd, n)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("vd.CellsOnGreatCircle(normals[%d]) mismatch (-want +got):\n%s", i, diff)
+		}
+		if got[0] != vd.CellContainingPoint(s2.Ortho(n)).SiteIndex() {
+			t.Errorf("vd.CellsOnGreatCircle(normals[%d])[0] = %d, want cell containing Ortho",
+				i, got[0])
+		}
+		assertSamplesInOrder(t, vd, n, got)
+	}
+}
+
+func TestDiagram_CellsOnGreatCircle_ThroughVertex(t *testing.T) {
+	vd := mustNewDiagram(t, 200)
+	for vIdx := range 10 {
+		v := vd.Vertices[vIdx]
+		n := s2.Point{Vector: v.Cross(s2.Ortho(v).Vector).Normalize()}
+		got := vd.CellsOnGreatCircle(n)
+		want := cellsOnGreatCircleBruteForce(vd, n)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("vd.CellsOnGreatCircle(through vertex %d) mismatch (-want +got):\n%s", vIdx,
+				diff)
+		}
+		assertSamplesInOrder(t, vd, n, got)
+	}
+}
+
+// assertSamplesInOrder checks that the cells hit by dense samples of the circle appear in cells
+// in the same cyclic order.
+func assertSamplesInOrder(t *testing.T, vd *Diagram, n s2.Point, cells []int) {
+	t.Helper()
+	u := s2.Ortho(n)
+	w := n.Cross(u.Vector)
+	pos := -1
+	for k := range 20000 {
+		theta := 2 * math.Pi * float64(k) / 20000
+		p := s2.Point{Vector: u.Mul(math.Cos(theta)).Add(w.Mul(math.Sin(theta))).Normalize()}
+		i := slices.Index(cells, nearestSiteBruteForce(vd, p))
+		if i < 0 {
+			t.Fatalf("sample at θ=%v hits cell not in result", theta)
+		}
+		if i == 0 && pos > 0 {
+			// The circle is back in the starting cell.
+			pos = len(cells)
+			continue
+		}
+		if i < pos {
+			t.Fatalf("sample at θ=%v hits cell %d out of order", theta, cells[i])
+		}
+		pos = i
+	}
+}
+
+// cellsOnGreatCircleBruteForce returns the cells that are nearest to some point of the circle,
+// i.e. the strict convex hull of all sites projected onto the circle plane, in CCW order
+// starting from the cell containing s2.Ortho(n).
+func cellsOnGreatCircleBruteForce(vd *Diagram, n s2.Point) []int {
+	u := s2.Ortho(n)
+	w := n.Cross(u.Vector)
+	q := make([]r2.Point, vd.NumCells())
+	idx := make([]int, vd.NumCells())
+	for i, s := range vd.Sites {
+		q[i] = r2.Point{X: s.Dot(u.Vector), Y: s.Dot(w)}
+		idx[i] = i
+	}
+	slices.SortFunc(idx, func(a, b int) int {
+		switch {
+		case q[a].X < q[b].X || q[a].X == q[b].X && q[a].Y < q[b].Y:
+			return -1
+		case q[a] == q[b]:
+			return 0
+		}
+		return 1
+	})
+
+	var hull []int
+	for pass := range 2 {
+		base := len(hull)
+		for _, i := range idx {
+			for len(hull) >= base+2 {
+				a, b := q[hull[len(hull)-2]], q[hull[len(hull)-1]]
+				if b.Sub(a).Cross(q[i].Sub(a)) > 1e-12 {
+					break
+				}
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, i)
+		}
+		hull = hull[:len(hull)-1]
+		if pass == 0 {
+			slices.Reverse(idx)
+		}
+	}
+
+	start := slices.Index(hull, vd.CellContainingPoint(u).SiteIndex())
+	return append(hull[start:], hull[:start]...)
+}
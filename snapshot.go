@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+// Snapshot returns a deep copy of the diagram that later mutations of d, such as Rebuild or
+// UpdateSitePositions, do not affect. The copy starts with empty caches.
+// A diagram that is not mutated is safe for concurrent readers, so a snapshot can be handed to
+// query goroutines while d keeps being updated.
+func (d *Diagram) Snapshot() *Diagram {
+	return &Diagram{
+		Sites:         slices.Clone(d.Sites),
+		Vertices:      slices.Clone(d.Vertices),
+		CellVertices:  slices.Clone(d.CellVertices),
+		CellNeighbors: slices.Clone(d.CellNeighbors),
+		CellOffsets:   slices.Clone(d.CellOffsets),
+		Dual:          d.Dual,
+		opts:          d.opts,
+		sitesHash:     d.sitesHash,
+	}
+}
+
+// DiagramHolder publishes a diagram to concurrent readers and lets a writer replace it
+// atomically. Readers keep using the diagram they loaded until they load again, so a stored
+// diagram must not be mutated; store a new diagram or a Snapshot instead.
+// The zero value holds no diagram and is ready to use.
+type DiagramHolder struct {
+	d atomic.Pointer[Diagram]
+}
+
+// Load returns the current diagram, or nil if none has been stored.
+func (h *DiagramHolder) Load() *Diagram {
+	return h.d.Load()
+}
+
+// Store replaces the current diagram with d.
+func (h *DiagramHolder) Store(d *Diagram) {
+	h.d.Store(d)
+}
+
+// WarmAndStore warms the caches of d with Diagram.Warm before replacing the current diagram
+// with it, so that readers never see it cold.
+func (h *DiagramHolder) WarmAndStore(d *Diagram) {
+	if d != nil {
+		d.Warm()
+	}
+	h.d.Store(d)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// Snapshot
+
+func TestDiagram_Snapshot(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	snap := vd.Snapshot()
+	if diff := cmp.Diff(vd, snap, cmpopts.IgnoreUnexported(Diagram{})); diff != "" {
+		t.Fatalf("vd.Snapshot() mismatch (-want +got):\n%s", diff)
+	}
+
+	want := snap.Snapshot()
+	if err := vd.Rebuild(utils.GenerateRandomPoints(100, 1)); err != nil {
+		t.Fatalf("vd.Rebuild(...) error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(want, snap, cmpopts.IgnoreUnexported(Diagram{})); diff != "" {
+		t.Errorf("snapshot changed after vd.Rebuild(...) (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiagramHolder(t *testing.T) {
+	var h DiagramHolder
+	if h.Load() != nil {
+		t.Fatalf("zero DiagramHolder.Load() = non-nil, want nil")
+	}
+	writer := mustNewDiagram(t, 100)
+	first := writer.Snapshot()
+	h.Store(first)
+	if h.Load() != first {
+		t.Fatalf("h.Load() did not return the stored diagram")
+	}
+
+	queries := utils.GenerateRandomPoints(100, 2)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				d := h.Load()
+				for _, p := range queries {
+					c := d.CellContainingPoint(p)
+					if c.SiteIndex() < 0 || c.SiteIndex() >= d.NumCells() || c.Area() <= 0 {
+						t.Errorf("invalid cell %d for loaded diagram", c.SiteIndex())
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for seed := range int64(10) {
+		if err := writer.Rebuild(utils.GenerateRandomPoints(100+int(seed), seed)); err != nil {
+			t.Fatalf("writer.Rebuild(...) error = %v, want nil", err)
+		}
+		h.WarmAndStore(writer.Snapshot())
+	}
+	close(stop)
+	wg.Wait()
+
+	if got := h.Load().NumCells(); got != 109 {
+		t.Errorf("h.Load().NumCells() = %d, want 109", got)
+	}
+}
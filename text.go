@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/2dChan/s2voronoi/internal/text"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	diagramTextKey     = "s2voronoi-diagram"
+	diagramTextVersion = 2
+)
+
+// MarshalText encodes the diagram and its numeric options in a line-oriented text format meant
+// for golden files. Coordinates are written as hexadecimal floating-point literals, so they
+// round-trip exactly. Vertices are numbered in the lexicographic order of the sorted indices of
+// the cells meeting at them, and the rings of every cell are rotated to start at its smallest
+// neighbor, so the output does not depend on the order in which the triangulation enumerated
+// its triangles. A VertexOverride callback cannot be encoded and is dropped.
+// It implements encoding.TextMarshaler and returns an error wrapping ErrNoNeighbors if the
+// diagram was built WithoutNeighbors, since the canonical order depends on the neighbors.
+func (d *Diagram) MarshalText() ([]byte, error) {
+	if !d.hasNeighbors() {
+		return nil, fmt.Errorf("MarshalText: %w", ErrNoNeighbors)
+	}
+	var w text.Writer
+	w.Int(diagramTextKey, diagramTextVersion)
+	w.Int("dual", int(d.Dual))
+	w.Float64("eps", d.opts.Eps)
+	autoEps := 0
+	if d.opts.AutoEps {
+		autoEps = 1
+	}
+	w.Int("autoeps", autoEps)
+	w.Float64("override-tolerance", d.opts.OverrideTolerance.Radians())
+	w.Int("idlevel", d.opts.IDLevel)
+	w.Float64("max-radius", d.opts.MaxRadius.Radians())
+	w.Points("sites", d.Sites)
+
+	cells := make([][]int, len(d.Vertices))
+	for i := range d.NumCells() {
+		for _, v := range (Cell{idx: i, d: d}).VertexIndices() {
+			if v < 0 || v >= len(cells) {
+				return nil, fmt.Errorf("MarshalText: cell vertex %d %w [0 %d)", v, ErrOutOfRange,
+					len(cells))
+			}
+			cells[v] = append(cells[v], i)
+		}
+	}
+	order := make([]int, len(d.Vertices))
+	for v := range order {
+		order[v] = v
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return slices.Compare(cells[a], cells[b]) })
+	rank := make([]int, len(order))
+	vertices := make(s2.PointVector, len(order))
+	for r, v := range order {
+		rank[v] = r
+		vertices[r] = d.Vertices[v]
+	}
+	w.Points("vertices", vertices)
+
+	w.Int("cells", d.NumCells())
+	for i := range d.NumCells() {
+		c := Cell{idx: i, d: d}
+		neighbors := c.NeighborIndices()
+		start := 0
+		for k, n := range neighbors {
+			if n < neighbors[start] {
+				start = k
+			}
+		}
+		ring := make([]int, len(neighbors))
+		for k, v := range c.VertexIndices() {
+			ring[(k-start+len(ring))%len(ring)] = rank[v]
+		}
+		w.Ints("v", ring)
+		w.Ints("n", canonicalRing(neighbors))
+	}
+	return w.Bytes(), nil
+}
+
+// UnmarshalText decodes a diagram produced by MarshalText, replacing the contents of d.
+// Vertices and rings are in the canonical order of the text, and the decoded arrays are
+// checked for structural consistency.
+// It implements encoding.TextUnmarshaler and returns an error wrapping ErrInvalidEncoding if
+// the text is malformed.
+func (d *Diagram) UnmarshalText(data []byte) error {
+	r := text.NewReader(data)
+	version := r.Int(diagramTextKey)
+	if r.Err() == nil && (version < 1 || version > diagramTextVersion) {
+		return fmt.Errorf("UnmarshalText: %w: unknown version %d", ErrInvalidEncoding, version)
+	}
+	dual := DualType(r.Int("dual"))
+	opts := DiagramOptions{Eps: r.Float64("eps")}
+	opts.AutoEps = r.Int("autoeps") != 0
+	opts.OverrideTolerance = s1.Angle(r.Float64("override-tolerance"))
+	opts.IDLevel = r.Int("idlevel")
+	if version >= 2 {
+		opts.MaxRadius = s1.Angle(r.Float64("max-radius"))
+	}
+	sites := r.Points("sites")
+	vertices := r.Points("vertices")
+	numCells := r.Int("cells")
+	if r.Err() == nil && numCells != len(sites) {
+		return fmt.Errorf("UnmarshalText: %w: %d cells for %d sites", ErrInvalidEncoding,
+			numCells, len(sites))
+	}
+	cellOffsets := []int{0}
+	var cellVertices, cellNeighbors []int
+	for i := range max(numCells, 0) {
+		ring := r.Ints("v")
+		neighbors := r.Ints("n")
+		if r.Err() != nil {
+			break
+		}
+		if len(ring) != len(neighbors) {
+			return fmt.Errorf("UnmarshalText: %w: cell %d has %d vertices and %d neighbors",
+				ErrInvalidEncoding, i, len(ring), len(neighbors))
+		}
+		cellVertices = append(cellVertices, ring...)
+		cellNeighbors = append(cellNeighbors, neighbors...)
+		cellOffsets = append(cellOffsets, len(cellVertices))
+	}
+	if err := r.Close(); err != nil {
+		return fmt.Errorf("UnmarshalText: %w: %w", ErrInvalidEncoding, err)
+	}
+	if dual != CircumcentricDual && dual != BarycentricDual {
+		return fmt.Errorf("UnmarshalText: %w: unknown dual type %d", ErrInvalidEncoding, dual)
+	}
+	if opts.IDLevel < 0 || opts.IDLevel > s2.MaxLevel {
+		return fmt.Errorf("UnmarshalText: %w: id level %d", ErrInvalidEncoding, opts.IDLevel)
+	}
+	if !(opts.MaxRadius >= 0 && opts.MaxRadius <= math.Pi) {
+		return fmt.Errorf("UnmarshalText: %w: max radius %v", ErrInvalidEncoding, opts.MaxRadius)
+	}
+
+	d.InvalidateCaches()
+	d.Sites = sites
+	d.recordSites()
+	d.Vertices = vertices
+	d.CellVertices = cellVertices
+	d.CellNeighbors = cellNeighbors
+	d.CellOffsets = cellOffsets
+	d.Dual = dual
+	d.opts = opts
+	if err := d.checkStructure(); err != nil {
+		return fmt.Errorf("UnmarshalText: %w: %w", ErrInvalidEncoding, err)
+	}
+	return nil
+}
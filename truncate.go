@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// WithMaxRadius truncates every cell to the cap of radius r around its site, leaving the parts
+// of the sphere farther than r from every site unclaimed. The cell arrays still describe the
+// full Voronoi cells; truncation affects Locate, IsTruncated, ClaimedArea and UnclaimedArea.
+// It must be in (0, π].
+func WithMaxRadius(r s1.Angle) DiagramOption {
+	return func(o *DiagramOptions) error {
+		if r <= 0 || r > math.Pi {
+			return &OptionError{Name: "WithMaxRadius",
+				Err: fmt.Errorf("%w: r must be in (0 π] got %v", ErrInvalidOption, r)}
+		}
+		o.MaxRadius = r
+		return nil
+	}
+}
+
+// NewTruncatedDiagram creates a Voronoi diagram whose cells are truncated to the cap of radius
+// maxRadius around their sites, modeling sites with a limited range. It is NewDiagram with
+// WithMaxRadius(maxRadius) applied after the other options.
+// It returns an error if maxRadius is not in (0, π] or the diagram cannot be constructed.
+func NewTruncatedDiagram(sites s2.PointVector, maxRadius s1.Angle, setters ...DiagramOption) (
+	*Diagram, error) {
+	return NewDiagram(sites, append(setters[:len(setters):len(setters)],
+		WithMaxRadius(maxRadius))...)
+}
+
+// MaxRadius returns the truncation radius of the cells, or 0 if they are not truncated.
+func (d *Diagram) MaxRadius() s1.Angle {
+	return d.opts.MaxRadius
+}
+
+// Locate returns the index of the truncated cell containing p, or -1 if p is farther than the
+// truncation radius from its nearest site. Without truncation it returns the index of the cell
+// containing p. Points on a truncation circle are claimed.
+func (d *Diagram) Locate(p s2.Point) int {
+	i := d.locate(p, 0)
+	if d.opts.MaxRadius > 0 && d.Sites[i].Distance(p) > d.opts.MaxRadius {
+		return -1
+	}
+	return i
+}
+
+// UnclaimedArea returns the area in steradians of the parts of the sphere outside every
+// truncated cell, or 0 if the cells are not truncated.
+func (d *Diagram) UnclaimedArea() float64 {
+	if d.opts.MaxRadius <= 0 {
+		return 0
+	}
+	claimed := 0.0
+	for i := range d.NumCells() {
+		claimed += Cell{idx: i, d: d}.ClaimedArea()
+	}
+	return max(4*math.Pi-claimed, 0)
+}
+
+// IsTruncated reports whether the cell extends beyond the truncation radius around its site,
+// so that its claimed region is smaller than the Voronoi cell.
+func (c Cell) IsTruncated() bool {
+	r := c.d.opts.MaxRadius
+	if r <= 0 {
+		return false
+	}
+	// An edge leaving the cap either has an endpoint beyond it or crosses its circle twice.
+	site := c.Site()
+	indices := c.VertexIndices()
+	for k, v := range indices {
+		a, b := c.d.Vertices[v], c.d.Vertices[indices[(k+1)%len(indices)]]
+		if site.Distance(a) > r || len(circleCrossings(site, r, a, b)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ClaimedArea returns the area in steradians of the cell intersected with the truncation cap
+// around its site, or the area of the whole cell if it is not truncated.
+func (c Cell) ClaimedArea() float64 {
+	if !c.IsTruncated() {
+		return c.Area()
+	}
+	r := c.d.opts.MaxRadius
+	site := c.Site()
+	indices := c.VertexIndices()
+	area := 0.0
+	for k, v := range indices {
+		a, b := c.d.Vertices[v], c.d.Vertices[indices[(k+1)%len(indices)]]
+		// The cell is the fan of triangles from the site over its edges. Each edge is split
+		// where it crosses the truncation circle, and every piece contributes its triangle
+		// inside the circle or the circular sector beyond it.
+		points := append([]s2.Point{a}, circleCrossings(site, r, a, b)...)
+		points = append(points, b)
+		for j := range len(points) - 1 {
+			p, q := points[j], points[j+1]
+			mid := s2.Interpolate(0.5, p, q)
+			if site.Distance(mid) <= r {
+				area += s2delaunay.SphericalTriangleArea(site, p, q)
+			} else {
+				area += (1 - math.Cos(r.Radians())) * s2.Angle(p, site, q).Radians()
+			}
+		}
+	}
+	return area
+}
+
+// circleCrossings returns the points where the geodesic from a to b crosses the circle of
+// radius r around center, in order from a.
+func circleCrossings(center s2.Point, r s1.Angle, a, b s2.Point) []s2.Point {
+	length := a.Distance(b).Radians()
+	if length == 0 {
+		return nil
+	}
+	// Along x(t) = a cos t + u sin t the distance condition center·x = cos r reads
+	// A cos t + B sin t = cos r.
+	u := s2.Point{Vector: b.Sub(a.Mul(a.Dot(b.Vector))).Normalize()}
+	A, B := center.Dot(a.Vector), center.Dot(u.Vector)
+	R := math.Hypot(A, B)
+	cr := math.Cos(r.Radians())
+	if R == 0 || math.Abs(cr) >= R {
+		return nil
+	}
+	phi := math.Atan2(B, A)
+	delta := math.Acos(cr / R)
+	var ts []float64
+	for _, t := range []float64{phi - delta, phi + delta} {
+		t = math.Mod(t+4*math.Pi, 2*math.Pi)
+		if t > 0 && t < length {
+			ts = append(ts, t)
+		}
+	}
+	if len(ts) == 2 && ts[0] > ts[1] {
+		ts[0], ts[1] = ts[1], ts[0]
+	}
+	points := make([]s2.Point, len(ts))
+	for i, t := range ts {
+		points[i] = s2.Point{Vector: a.Mul(math.Cos(t)).Add(u.Mul(math.Sin(t))).Normalize()}
+	}
+	return points
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Truncate
+
+func TestWithMaxRadius(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       s1.Angle
+		wantErr bool
+	}{
+		{"positive", 0.1, false},
+		{"pi", math.Pi, false},
+		{"zero", 0, true},
+		{"negative", -0.1, true},
+		{"too large", math.Pi + 0.1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts DiagramOptions
+			err := WithMaxRadius(tt.r)(&opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WithMaxRadius(%v) error = %v, wantErr %v", tt.r, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidOption) {
+				t.Errorf("WithMaxRadius(%v) error = %v, want ErrInvalidOption", tt.r, err)
+			}
+		})
+	}
+}
+
+func TestNewTruncatedDiagram_SmallRadius(t *testing.T) {
+	sites := utils.GenerateRandomPoints(100, 0)
+	full := mustNewDiagram(t, 100)
+	// Keep every cap strictly inside its cell.
+	r := s1.Angle(math.Inf(1))
+	for i := range full.NumCells() {
+		c, _ := full.Cell(i)
+		for _, n := range c.NeighborIndices() {
+			r = min(r, full.Sites[i].Distance(full.Sites[n])/4)
+		}
+	}
+
+	vd, err := NewTruncatedDiagram(sites, r)
+	if err != nil {
+		t.Fatalf("NewTruncatedDiagram(...) error = %v, want nil", err)
+	}
+	capArea := (s2.CapFromCenterAngle(sites[0], r)).Area()
+	for i := range vd.NumCells() {
+		c, _ := vd.Cell(i)
+		if !c.IsTruncated() {
+			t.Errorf("cell %d: c.IsTruncated() = false, want true", i)
+		}
+		if got := c.ClaimedArea(); math.Abs(got-capArea) > 1e-12 {
+			t.Errorf("cell %d: c.ClaimedArea() = %v, want %v", i, got, capArea)
+		}
+	}
+	want := 4*math.Pi - float64(vd.NumCells())*capArea
+	if got := vd.UnclaimedArea(); math.Abs(got-want) > 1e-10 {
+		t.Errorf("vd.UnclaimedArea() = %v, want %v", got, want)
+	}
+}
+
+func TestNewTruncatedDiagram_Locate(t *testing.T) {
+	sites := utils.GenerateRandomPoints(100, 0)
+	const r = s1.Angle(0.15)
+	vd, err := NewTruncatedDiagram(sites, r)
+	if err != nil {
+		t.Fatalf("NewTruncatedDiagram(...) error = %v, want nil", err)
+	}
+
+	tested := 0
+	for i := range vd.NumCells() {
+		c, _ := vd.Cell(i)
+		site := c.Site()
+		for k := range c.NumVertices() {
+			v, _ := c.Vertex(k)
+			if site.Distance(v) < r+1e-3 {
+				continue
+			}
+			tested++
+			inside := s2.InterpolateAtDistance(r-1e-9, site, v)
+			outside := s2.InterpolateAtDistance(r+1e-9, site, v)
+			if got := vd.Locate(inside); got != i {
+				t.Errorf("cell %d: vd.Locate(just inside) = %d, want %d", i, got, i)
+			}
+			if got := vd.Locate(outside); got != -1 {
+				t.Errorf("cell %d: vd.Locate(just outside) = %d, want -1", i, got)
+			}
+		}
+	}
+	if tested == 0 {
+		t.Fatalf("no vertex beyond the truncation radius")
+	}
+
+	// Compare the unclaimed area with the fraction of uniform samples left unclaimed.
+	rng := rand.New(rand.NewSource(1))
+	const samples = 200000
+	unclaimed := 0
+	for range samples {
+		p := s2.Point{Vector: s2.PointFromCoords(rng.NormFloat64(), rng.NormFloat64(),
+			rng.NormFloat64()).Vector}
+		if vd.Locate(p) < 0 {
+			unclaimed++
+		}
+	}
+	frac := float64(unclaimed) / samples
+	want := frac * 4 * math.Pi
+	sigma := math.Sqrt(frac*(1-frac)/samples) * 4 * math.Pi
+	if got := vd.UnclaimedArea(); math.Abs(got-want) > 4*sigma {
+		t.Errorf("vd.UnclaimedArea() = %v, want %v ± %v", got, want, 4*sigma)
+	}
+
+	data, err := vd.MarshalBinary()
+	if err != nil {
+		t.Fatalf("vd.MarshalBinary() error = %v, want nil", err)
+	}
+	decoded := new(Diagram)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("decoded.UnmarshalBinary(...) error = %v, want nil", err)
+	}
+	if decoded.MaxRadius() != r {
+		t.Errorf("decoded.MaxRadius() = %v, want %v", decoded.MaxRadius(), r)
+	}
+}
+
+func TestDiagram_UnclaimedArea_Untruncated(t *testing.T) {
+	vd := mustNewDiagram(t, 100)
+	if got := vd.UnclaimedArea(); got != 0 {
+		t.Errorf("vd.UnclaimedArea() = %v, want 0", got)
+	}
+	wide, err := NewTruncatedDiagram(vd.Sites, math.Pi)
+	if err != nil {
+		t.Fatalf("NewTruncatedDiagram(...) error = %v, want nil", err)
+	}
+	if got := wide.UnclaimedArea(); got > 1e-12 {
+		t.Errorf("wide.UnclaimedArea() = %v, want 0", got)
+	}
+	for i := range wide.NumCells() {
+		c, _ := wide.Cell(i)
+		if c.IsTruncated() {
+			t.Errorf("cell %d: c.IsTruncated() = true, want false", i)
+		}
+	}
+}
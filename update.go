@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/s2"
+)
+
+// UpdateSitePositions moves the sites to newSites, given in the same order, without rebuilding
+// the diagram when its connectivity is still the Delaunay triangulation of the moved sites.
+// Every triangle must stay CCW and every edge must stay Delaunay, in which case only the
+// vertices are recomputed in place, honoring the dual type and VertexOverride, and changed is
+// false. Otherwise the diagram is left untouched and changed is true, and the caller should
+// Rebuild it. Like Rebuild, the diagram stores a copy of newSites as its Sites, so a
+// triangulation it shares storage with is left unmodified.
+// It returns an error if the number of sites differs, a *s2delaunay.VertexError if a site has
+// a non-finite component, is the zero vector or is not unit length, in which case the diagram
+// is left untouched, an error if an overridden vertex is invalid, in which case the diagram is
+// left in an unspecified state, or an error wrapping ErrNoNeighbors if the diagram was built
+// WithoutNeighbors.
+func (d *Diagram) UpdateSitePositions(newSites s2.PointVector) (changed bool, err error) {
+	if !d.hasNeighbors() {
+		return false, fmt.Errorf("UpdateSitePositions: %w", ErrNoNeighbors)
+	}
+	if len(newSites) != d.NumCells() {
+		return false, fmt.Errorf("UpdateSitePositions: got %d sites, want %d", len(newSites),
+			d.NumCells())
+	}
+	for i, p := range newSites {
+		if !isUnitPoint(p) {
+			return false, fmt.Errorf("UpdateSitePositions: %w",
+				&s2delaunay.VertexError{Index: i, Vertex: p})
+		}
+	}
+
+	// Each vertex is dual to the triangle (i, n_k, n_k-1) formed with two consecutive
+	// neighbors of any of its cells, and the edge to n_k is shared with (i, n_k+1, n_k).
+	triangles := make([]s2delaunay.Triangle, len(d.Vertices))
+	for i := range d.NumCells() {
+		cell := Cell{idx: i, d: d}
+		neighbors := cell.NeighborIndices()
+		m := len(neighbors)
+		for k, v := range cell.VertexIndices() {
+			next, prev := neighbors[k], neighbors[(k+m-1)%m]
+			a, b, c := newSites[i], newSites[next], newSites[prev]
+			if s2.RobustSign(a, b, c) != s2.CounterClockwise {
+				return true, nil
+			}
+			if i < next && s2delaunay.InCircumcap(a, b, c, newSites[neighbors[(k+1)%m]]) {
+				return true, nil
+			}
+			triangles[v] = s2delaunay.Triangle{i, next, prev}
+		}
+	}
+
+	d.InvalidateCaches()
+	d.Sites = slices.Clone(newSites)
+	d.recordSites()
+	for v, tri := range triangles {
+		p := [3]s2.Point{newSites[tri[0]], newSites[tri[1]], newSites[tri[2]]}
+		if d.opts.VertexOverride != nil {
+			if o, ok := d.opts.VertexOverride(p, v); ok {
+				if err := validateVertex(o, p, d.opts.OverrideTolerance); err != nil {
+					return false, fmt.Errorf("UpdateSitePositions: override for triangle %d: %w",
+						v, err)
+				}
+				d.Vertices[v] = o
+				continue
+			}
+		}
+		d.Vertices[v] = dualVertex(d.Dual, p)
+	}
+	if d.opts.RingRepair {
+		d.repairRings(triangles)
+	}
+	return false, nil
+}
+
+// isUnitPoint reports whether p has finite components and unit norm within
+// unitNormTolerance, as NewDiagram requires of its sites.
+func isUnitPoint(p s2.Point) bool {
+	n := p.Norm()
+	return !math.IsNaN(n) && !math.IsInf(n, 0) && math.Abs(n-1) <= unitNormTolerance
+}
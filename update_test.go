@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Andrey Kriulin
+// Licensed under the MIT License.
+// See the LICENSE file in the project root for full license text.
+
+package s2voronoi
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/2dChan/s2voronoi/utils"
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s2"
+)
+
+// Update
+
+func TestDiagram_UpdateSitePositions(t *testing.T) {
+	points := utils.GenerateRandomPoints(200, 0)
+	vd, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	moved := make(s2.PointVector, len(points))
+	for i, p := range points {
+		moved[i] = s2.Point{Vector: p.Add(s2.Ortho(p).Mul(1e-7)).Normalize()}
+	}
+
+	changed, err := vd.UpdateSitePositions(moved)
+	if err != nil || changed {
+		t.Fatalf("vd.UpdateSitePositions(...) = %v, %v, want false, nil", changed, err)
+	}
+	want, err := NewDiagram(moved)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	for i := range vd.NumCells() {
+		got := cellVertexPoints(vd, i)
+		wantPoints := cellVertexPoints(want, i)
+		if len(got) != len(wantPoints) {
+			t.Fatalf("cell %d has %d vertices, want %d", i, len(got), len(wantPoints))
+		}
+		for _, p := range got {
+			if !slices.ContainsFunc(wantPoints, func(q s2.Point) bool {
+				return p.Distance(q) < 1e-12
+			}) {
+				t.Errorf("cell %d vertex %v not in rebuilt diagram", i, p)
+			}
+		}
+		c, _ := vd.Cell(i)
+		wc, _ := want.Cell(i)
+		if math.Abs(c.Area()-wc.Area()) > 1e-12 {
+			t.Errorf("cell %d area = %v, want %v", i, c.Area(), wc.Area())
+		}
+	}
+}
+
+func TestDiagram_UpdateSitePositions_SharedStorage(t *testing.T) {
+	dt, err := s2delaunay.NewTriangulation(utils.GenerateRandomPoints(200, 0))
+	if err != nil {
+		t.Fatalf("NewTriangulation(...) error = %v, want nil", err)
+	}
+	vd, err := NewDiagramFromTriangulation(dt, WithSharedStorage())
+	if err != nil {
+		t.Fatalf("NewDiagramFromTriangulation(...) error = %v, want nil", err)
+	}
+	moved := make(s2.PointVector, len(dt.Vertices))
+	for i, p := range dt.Vertices {
+		moved[i] = s2.Point{Vector: p.Add(s2.Ortho(p).Mul(1e-7)).Normalize()}
+	}
+
+	if changed, err := vd.UpdateSitePositions(moved); err != nil || changed {
+		t.Fatalf("vd.UpdateSitePositions(...) = %v, %v, want false, nil", changed, err)
+	}
+	if err := dt.Validate(0); err != nil {
+		t.Errorf("dt.Validate(0) error = %v, want nil", err)
+	}
+	if !slices.Equal(vd.Sites, moved) {
+		t.Errorf("vd.Sites = %v, want the moved sites", vd.Sites)
+	}
+}
+
+func TestDiagram_UpdateSitePositions_TopologyChange(t *testing.T) {
+	points := utils.GenerateRandomPoints(200, 0)
+	vd, err := NewDiagram(points)
+	if err != nil {
+		t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+	}
+	before := slices.Clone(vd.Vertices)
+	moved := slices.Clone(points)
+	moved[0] = s2.Point{Vector: points[0].Mul(-1)}
+
+	changed, err := vd.UpdateSitePositions(moved)
+	if err != nil || !changed {
+		t.Fatalf("vd.UpdateSitePositions(...) = %v, %v, want true, nil", changed, err)
+	}
+	if !slices.Equal(vd.Vertices, before) || !slices.Equal(vd.Sites, points) {
+		t.Errorf("vd modified after detecting a topology change")
+	}
+
+	if _, err := vd.UpdateSitePositions(moved[1:]); err == nil {
+		t.Errorf("vd.UpdateSitePositions(%d sites) error = nil, want non-nil", len(moved)-1)
+	}
+}
+
+func TestDiagram_UpdateSitePositions_InvalidSite(t *testing.T) {
+	tests := []struct {
+		name string
+		site s2.Point
+	}{
+		{"nan", s2.Point{Vector: r3.Vector{X: math.NaN(), Y: 0, Z: 1}}},
+		{"non-unit", s2.Point{Vector: r3.Vector{X: 0, Y: 0, Z: 2}}},
+		{"zero", s2.Point{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := utils.GenerateRandomPoints(50, 0)
+			vd, err := NewDiagram(points)
+			if err != nil {
+				t.Fatalf("NewDiagram(...) error = %v, want nil", err)
+			}
+			moved := slices.Clone(points)
+			moved[7] = tt.site
+			_, err = vd.UpdateSitePositions(moved)
+			var ve *s2delaunay.VertexError
+			if !errors.As(err, &ve) || ve.Index != 7 || !errors.Is(err, s2delaunay.ErrInvalidVertex) {
+				t.Fatalf("vd.UpdateSitePositions(...) error = %v, want VertexError at 7", err)
+			}
+			if !slices.Equal(vd.Sites, points) {
+				t.Errorf("vd.Sites changed after a rejected update")
+			}
+		})
+	}
+}
+
+// cellVertexPoints returns the vertex positions of cell i.
+func cellVertexPoints(vd *Diagram, i int) []s2.Point {
+	c, _ := vd.Cell(i)
+	points := make([]s2.Point, c.NumVertices())
+	for k := range points {
+		points[k], _ = c.Vertex(k)
+	}
+	return points
+}
@@ -7,8 +7,11 @@
 package utils
 
 import (
+	"cmp"
+	"container/heap"
 	"math"
 	"math/rand"
+	"slices"
 
 	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
@@ -30,3 +33,160 @@ func GenerateRandomPoints(cnt int, seed int64) s2.PointVector {
 
 	return sites
 }
+
+// GenerateGraticulePoints generates the regular latitude/longitude grid with the given steps in
+// degrees: one point at each pole, and between them rings of constant latitude at multiples of
+// latStep from the south pole, each holding points at multiples of lngStep from -180 degrees.
+// Steps that do not divide 180 and 360 evenly leave a narrower last gap before the north pole
+// and before -180 degrees respectively. Non-positive steps return nil.
+func GenerateGraticulePoints(latStep, lngStep float64) s2.PointVector {
+	if latStep <= 0 || lngStep <= 0 {
+		return nil
+	}
+	// Grid lines within graticuleEps degrees of a pole or the antimeridian coincide with it.
+	const graticuleEps = 1e-9
+
+	points := s2.PointVector{s2.PointFromCoords(0, 0, -1)}
+	for i := 1; float64(i)*latStep < 180-graticuleEps; i++ {
+		lat := -90 + float64(i)*latStep
+		for j := 0; float64(j)*lngStep < 360-graticuleEps; j++ {
+			lng := -180 + float64(j)*lngStep
+			points = append(points, s2.PointFromLatLng(s2.LatLngFromDegrees(lat, lng)))
+		}
+	}
+	return append(points, s2.PointFromCoords(0, 0, 1))
+}
+
+// WeldPoints merges points lying within tol of each other into a single representative.
+// Points are processed in input order, and each point is merged into the first earlier
+// representative within tol, otherwise it becomes a new representative. It returns the
+// representatives in order of first appearance and a remap from each input index to the index
+// of its representative in welded.
+func WeldPoints(points s2.PointVector, tol s1.Angle) (s2.PointVector, []int) {
+	level := s2.MinWidthMetric.MaxLevel(tol.Radians())
+	buckets := make(map[s2.CellID][]int)
+	welded := make(s2.PointVector, 0, len(points))
+	remap := make([]int, len(points))
+
+	for i, p := range points {
+		id := s2.CellFromPoint(p).ID().Parent(level)
+		remap[i] = -1
+		for _, nid := range append(id.AllNeighbors(level), id) {
+			for _, w := range buckets[nid] {
+				if (remap[i] < 0 || w < remap[i]) && welded[w].Distance(p) <= tol {
+					remap[i] = w
+				}
+			}
+		}
+		if remap[i] < 0 {
+			remap[i] = len(welded)
+			buckets[id] = append(buckets[id], len(welded))
+			welded = append(welded, p)
+		}
+	}
+
+	return welded, remap
+}
+
+// FarthestPointSample selects k well-spread points from candidates by farthest-point sampling:
+// the first point is drawn with rng, and each following point is the candidate farthest from
+// all points selected so far, ties going to the lowest index. The output is deterministic for
+// a fixed rng state. Candidates coinciding with a selected point are never selected, so fewer
+// than k points are returned when there are fewer distinct candidates.
+// Candidates are sorted along the S2 cell ID curve so that each selection only revisits those
+// within a covering of the cap that can get closer, making selections cheap once the spacing is
+// small rather than scanning all candidates.
+func FarthestPointSample(candidates s2.PointVector, k int, rng *rand.Rand) s2.PointVector {
+	n := len(candidates)
+	k = min(k, n)
+	if k <= 0 {
+		return nil
+	}
+
+	ids := make([]s2.CellID, n)
+	order := make([]int, n)
+	for i, p := range candidates {
+		ids[i] = s2.CellFromPoint(p).ID()
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int { return cmp.Compare(ids[a], ids[b]) })
+	sortedIDs := make([]s2.CellID, n)
+	for pos, i := range order {
+		sortedIDs[pos] = ids[i]
+	}
+
+	dist := make([]s1.ChordAngle, n)
+	for i := range dist {
+		dist[i] = s1.InfChordAngle()
+	}
+	q := &farthestQueue{}
+	coverer := &s2.RegionCoverer{MaxLevel: s2.MaxLevel, MaxCells: 8}
+	selected := make(s2.PointVector, 0, k)
+	next := rng.Intn(n)
+	for len(selected) < k {
+		s := candidates[next]
+		selected = append(selected, s)
+		radius := dist[next]
+		dist[next] = 0
+
+		// Only candidates currently farther from the selection than from s are updated, and
+		// none is farther than s itself was, so later updates stay within a cap around s.
+		update := func(indices []int) {
+			for _, i := range indices {
+				if d := s2.ChordAngleBetweenPoints(s, candidates[i]); d < dist[i] {
+					dist[i] = d
+					heap.Push(q, farthestCandidate{i, d})
+				}
+			}
+		}
+		if radius == s1.InfChordAngle() {
+			update(order)
+		} else {
+			for _, c := range coverer.Covering(s2.CapFromCenterChordAngle(s, radius)) {
+				lo, _ := slices.BinarySearch(sortedIDs, c.RangeMin())
+				hi, _ := slices.BinarySearch(sortedIDs, c.RangeMax()+1)
+				update(order[lo:hi])
+			}
+		}
+
+		next = -1
+		for q.Len() > 0 {
+			top := (*q)[0]
+			if top.dist == dist[top.idx] && top.dist > 0 {
+				next = top.idx
+				break
+			}
+			heap.Pop(q)
+		}
+		if next < 0 {
+			break
+		}
+	}
+	return selected
+}
+
+// farthestCandidate is a candidate index and its distance to the nearest selected point.
+type farthestCandidate struct {
+	idx  int
+	dist s1.ChordAngle
+}
+
+// farthestQueue is a max-heap of candidates by distance, ties broken by the lower index.
+// Entries are not updated in place; stale ones are discarded when they reach the top.
+type farthestQueue []farthestCandidate
+
+func (q farthestQueue) Len() int { return len(q) }
+func (q farthestQueue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist > q[j].dist
+	}
+	return q[i].idx < q[j].idx
+}
+func (q farthestQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *farthestQueue) Push(x any)   { *q = append(*q, x.(farthestCandidate)) }
+func (q *farthestQueue) Pop() any {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
@@ -6,8 +6,11 @@ package utils
 
 import (
 	"math"
+	"math/rand"
 	"testing"
 
+	"github.com/2dChan/s2voronoi/s2delaunay"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"github.com/google/go-cmp/cmp"
 )
@@ -61,3 +64,146 @@ func TestGenerateRandomPoints_Determinism(t *testing.T) {
 		t.Errorf("GenerateRandomPoints(%v, %v) mismatch (-want +got):\n%s", cnt, seed, diff)
 	}
 }
+
+func TestGenerateGraticulePoints(t *testing.T) {
+	tests := []struct {
+		name             string
+		latStep, lngStep float64
+		want             int
+	}{
+		{"even", 30, 45, 2 + 5*8},
+		{"uneven", 50, 100, 2 + 3*4},
+		{"poles only", 180, 90, 2},
+		{"zero", 0, 10, 0},
+		{"negative", 10, -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GenerateGraticulePoints(tt.latStep, tt.lngStep)
+			if len(got) != tt.want {
+				t.Fatalf("len(GenerateGraticulePoints(%v, %v)) = %d, want %d", tt.latStep,
+					tt.lngStep, len(got), tt.want)
+			}
+			seen := make(map[s2.Point]bool)
+			for _, p := range got {
+				if seen[p] {
+					t.Errorf("duplicate point %v", p)
+				}
+				seen[p] = true
+				if lat := s2.LatLngFromPoint(p).Lat.Degrees(); math.Abs(lat) != 90 &&
+					math.Abs(math.Remainder(lat+90, tt.latStep)) > 1e-9 {
+					t.Errorf("point %v latitude %v is not on the grid", p, lat)
+				}
+			}
+		})
+	}
+}
+
+func TestWeldPoints(t *testing.T) {
+	base := GenerateRandomPoints(100, 0)
+	points := append(s2.PointVector{}, base...)
+	for i := 0; i < 100; i += 10 {
+		offset := s2.Ortho(base[i]).Mul(1e-9)
+		points = append(points, base[i], s2.Point{Vector: base[i].Add(offset).Normalize()})
+	}
+	const tol = s1.Angle(1e-8)
+
+	welded, remap := WeldPoints(points, tol)
+	if diff := cmp.Diff(base, welded); diff != "" {
+		t.Errorf("WeldPoints(...) welded mismatch (-want +got):\n%s", diff)
+	}
+	if len(remap) != len(points) {
+		t.Fatalf("WeldPoints(...) len(remap) = %d, want %d", len(remap), len(points))
+	}
+	for i, w := range remap {
+		if d := welded[w].Distance(points[i]); d > tol {
+			t.Errorf("points[%d] remapped %v away from its representative, want <= %v", i, d, tol)
+		}
+	}
+	for i := 100; i < len(points); i++ {
+		if want := (i - 100) / 2 * 10; remap[i] != want {
+			t.Errorf("remap[%d] = %d, want %d", i, remap[i], want)
+		}
+	}
+
+	if _, err := s2delaunay.NewTriangulation(welded); err != nil {
+		t.Errorf("s2delaunay.NewTriangulation(welded) error = %v, want nil", err)
+	}
+}
+
+func TestWeldPoints_ZeroTolerance(t *testing.T) {
+	p := GenerateRandomPoints(2, 0)
+	near := s2.Point{Vector: p[0].Add(s2.Ortho(p[0]).Mul(1e-9)).Normalize()}
+	welded, remap := WeldPoints(s2.PointVector{p[0], p[1], p[0], near}, 0)
+	if len(welded) != 3 {
+		t.Errorf("WeldPoints(..., 0) len(welded) = %d, want 3", len(welded))
+	}
+	if diff := cmp.Diff([]int{0, 1, 0, 2}, remap); diff != "" {
+		t.Errorf("WeldPoints(..., 0) remap mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFarthestPointSample(t *testing.T) {
+	candidates := GenerateRandomPoints(5000, 0)
+	const k = 200
+
+	got := FarthestPointSample(candidates, k, rand.New(rand.NewSource(1)))
+	if len(got) != k {
+		t.Fatalf("len(FarthestPointSample(...)) = %d, want %d", len(got), k)
+	}
+	want := farthestPointSampleBruteForce(candidates, k, rand.New(rand.NewSource(1)))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FarthestPointSample(...) mismatch (-want +got):\n%s", diff)
+	}
+
+	random := make(s2.PointVector, k)
+	for i, j := range rand.New(rand.NewSource(1)).Perm(len(candidates))[:k] {
+		random[i] = candidates[j]
+	}
+	if fps, rnd := minPairwiseDistance(got), minPairwiseDistance(random); fps <= rnd {
+		t.Errorf("min pairwise distance = %v, want > %v of random sampling", fps, rnd)
+	}
+}
+
+func TestFarthestPointSample_Duplicates(t *testing.T) {
+	base := GenerateRandomPoints(10, 0)
+	candidates := append(append(s2.PointVector{}, base...), base...)
+	if got := FarthestPointSample(candidates, 15, rand.New(rand.NewSource(0))); len(got) != 10 {
+		t.Errorf("len(FarthestPointSample(...)) = %d, want 10 distinct points", len(got))
+	}
+	if got := FarthestPointSample(candidates, 0, rand.New(rand.NewSource(0))); got != nil {
+		t.Errorf("FarthestPointSample(..., 0, ...) = %v, want nil", got)
+	}
+}
+
+func farthestPointSampleBruteForce(candidates s2.PointVector, k int,
+	rng *rand.Rand) s2.PointVector {
+	dist := make([]s1.ChordAngle, len(candidates))
+	for i := range dist {
+		dist[i] = s1.InfChordAngle()
+	}
+	next := rng.Intn(len(candidates))
+	var selected s2.PointVector
+	for len(selected) < k {
+		s := candidates[next]
+		selected = append(selected, s)
+		next = -1
+		for i, p := range candidates {
+			dist[i] = min(dist[i], s2.ChordAngleBetweenPoints(s, p))
+			if dist[i] > 0 && (next < 0 || dist[i] > dist[next]) {
+				next = i
+			}
+		}
+	}
+	return selected
+}
+
+func minPairwiseDistance(points s2.PointVector) s1.Angle {
+	best := s1.Angle(math.Inf(1))
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			best = min(best, points[i].Distance(points[j]))
+		}
+	}
+	return best
+}